@@ -127,6 +127,15 @@ func (c *AerospikeCluster) setDefaults(asLog logr.Logger) error {
 		c.Spec.ValidationPolicy = &validationPolicy
 	}
 
+	// Server health refresh period
+	if c.Spec.ServerHealth != nil && c.Spec.ServerHealth.RefreshPeriod.Duration == 0 {
+		c.Spec.ServerHealth.RefreshPeriod.Duration = DefaultServerHealthRefreshPeriod
+	}
+
+	if c.Spec.Autoscaling != nil {
+		c.Spec.Autoscaling.setDefaults()
+	}
+
 	// Update rosterNodeBlockList
 	for idx, nodeID := range c.Spec.RosterNodeBlockList {
 		c.Spec.RosterNodeBlockList[idx] = strings.TrimLeft(strings.ToUpper(nodeID), "0")
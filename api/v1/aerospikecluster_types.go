@@ -49,6 +49,53 @@ const (
 	AerospikeClusterError AerospikeClusterPhase = "Error"
 )
 
+// These are the condition types set in the AerospikeCluster status.
+const (
+	// ConditionTypeAvailable indicates that the cluster has been reconciled and all its pods are running and ready.
+	// It is set to False when a reconcile fails or when pods are not running and ready after a reconcile.
+	ConditionTypeAvailable = "Available"
+
+	// ConditionTypeProgressing indicates that the operator is rolling out changes to the cluster.
+	ConditionTypeProgressing = "Progressing"
+
+	// ConditionTypeDegraded indicates that the last reconcile failed and the cluster may not match the desired spec,
+	// or that a failed image upgrade was rolled back.
+	ConditionTypeDegraded = "Degraded"
+
+	// ConditionTypeMigrationsPending indicates that the cluster has partition migrations in progress.
+	ConditionTypeMigrationsPending = "MigrationsPending"
+
+	// ConditionTypeACLReconciled indicates whether the access control spec has been applied to the cluster.
+	ConditionTypeACLReconciled = "ACLReconciled"
+
+	// ConditionTypeRosterApplied indicates whether the roster has been set for strong consistency namespaces.
+	ConditionTypeRosterApplied = "RosterApplied"
+
+	// ConditionTypeConfigDriftDetected indicates that the desired aerospikeConfig differs from the config last
+	// applied to the cluster.
+	ConditionTypeConfigDriftDetected = "ConfigDriftDetected"
+)
+
+// These are the reasons for the conditions set in the AerospikeCluster status.
+const (
+	ConditionReasonReconciling          = "Reconciling"
+	ConditionReasonReconcileSucceeded   = "ReconcileSucceeded"
+	ConditionReasonReconcileFailed      = "ReconcileFailed"
+	ConditionReasonMigrationsInProgress = "MigrationsInProgress"
+	ConditionReasonMigrationsComplete   = "MigrationsComplete"
+	ConditionReasonACLReconciled        = "ACLReconciled"
+	ConditionReasonACLReconcileFailed   = "ACLReconcileFailed"
+	ConditionReasonSecurityDisabled     = "SecurityDisabled"
+	ConditionReasonRosterApplied        = "RosterApplied"
+	ConditionReasonRosterApplyFailed    = "RosterApplyFailed"
+	ConditionReasonConfigInSync         = "ConfigInSync"
+	ConditionReasonConfigUpdatePending  = "ConfigUpdatePending"
+	ConditionReasonUpgradeRolledBack    = "UpgradeRolledBack"
+	ConditionReasonMaintenanceWindow    = "WaitingForMaintenanceWindow"
+	ConditionReasonRackRebalancing      = "RackRebalancing"
+	ConditionReasonPodsNotReady         = "PodsNotReady"
+)
+
 // +kubebuilder:validation:Enum=Failed;PartiallyFailed;""
 type DynamicConfigUpdateStatus string
 
@@ -154,16 +201,629 @@ type AerospikeClusterSpec struct { //nolint:govet // for readability
 	// +optional
 	K8sNodeBlockList []string `json:"k8sNodeBlockList,omitempty"`
 
+	// ScaleDownSelection selects the pods to remove in a scale-down instead of the highest ordinal pods, e.g. the
+	// pods on a known-bad Kubernetes node. StatefulSet ordinals are kept contiguous: the highest ordinal pods are
+	// removed as usual and each selected pod that is not among them is replaced: once the migrations are complete
+	// it is quiesced, all its PVCs are deleted and it is recreated as an empty Aerospike node on another Kubernetes
+	// node. It can be set or extended only along with a scale-down.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Scale Down Selection"
+	// +optional
+	ScaleDownSelection *ScaleDownSelection `json:"scaleDownSelection,omitempty"`
+
 	// Paused flag is used to pause the reconciliation for the AerospikeCluster.
+	// The server health snapshot is not refreshed while the reconciliation is paused, unless the
+	// asdb.aerospike.com/refresh-server-health-while-paused annotation is set to "true", e.g. by a cluster migration
+	// to track the XDR queue of the paused source cluster.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pause Reconcile"
 	// +optional
 	Paused *bool `json:"paused,omitempty"`
 
-	// Operations is a list of on-demand operations to be performed on the Aerospike cluster.
+	// Operations is an ordered queue of on-demand operations to be performed on the Aerospike cluster.
+	// Operations are executed one after another in the given order. New operations can only be added after the
+	// operations which have started, and the queued operations cannot be reordered. The running operation cannot be
+	// removed, unless it failed. Pending and finished operations can be removed.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Operations"
-	// +kubebuilder:validation:MaxItems:=1
 	// +optional
 	Operations []OperationSpec `json:"operations,omitempty"`
+
+	// ServerHealth enables a periodic health snapshot of the Aerospike server in status.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Server Health"
+	// +optional
+	ServerHealth *ServerHealthSpec `json:"serverHealth,omitempty"`
+
+	// Autoscaling scales spec.size between the given bounds based on the namespace usage reported in
+	// status.serverHealth, so spec.serverHealth is required. The usage is evaluated at each server health refresh.
+	// A paused cluster is not scaled.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Autoscaling"
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+
+	// StorageClassMigration allows changing the storage class of the persistent volumes in the storage spec.
+	// The PVCs of the volumes with a changed storage class are replaced one pod at a time, waiting for the data
+	// migrations to complete between pods. The new volumes are initialized with their initMethod and Aerospike
+	// repopulates their data from the other nodes.
+	// The storage class can be changed only if all the namespaces have a replication-factor of at least 2.
+	// This field cannot be removed before status.storageClassMigration is Completed.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Storage Class Migration"
+	// +optional
+	StorageClassMigration *StorageClassMigrationSpec `json:"storageClassMigration,omitempty"`
+
+	// VolumeSnapshotSource is the name of a ready AerospikeVolumeSnapshot in the cluster namespace to provision
+	// the PVCs of the cluster pods from. The PVC of a pod volume is provisioned from the VolumeSnapshot of the
+	// same volume, rack and pod ordinal, the other PVCs are provisioned empty. The persistent volumes should have
+	// initMethod none so that the restored data is not initialized. It is used only when the cluster is created,
+	// and can only be removed afterwards.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Volume Snapshot Source"
+	// +optional
+	VolumeSnapshotSource string `json:"volumeSnapshotSource,omitempty"`
+
+	// ReconcilePolicy controls whether the changes in the spec are applied to the cluster.
+	// Apply is the default. PlanOnly computes the changes needed to reach the spec without applying them,
+	// and reports them in status.plan.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Reconcile Policy"
+	// +kubebuilder:validation:Enum=Apply;PlanOnly
+	// +optional
+	ReconcilePolicy ReconcilePolicy `json:"reconcilePolicy,omitempty"`
+
+	// SecondaryIndexes is the list of secondary indexes managed by the operator.
+	// Missing indexes are created and indexes removed from the list are dropped.
+	// Indexes with a different definition in the server, e.g. a different indextype or context, are recreated.
+	// Indexes not in the list and never managed by the operator are left untouched.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secondary Indexes"
+	// +optional
+	SecondaryIndexes []SecondaryIndexSpec `json:"secondaryIndexes,omitempty"`
+
+	// UDFModules is the list of ConfigMaps having the Lua UDF modules managed by the operator.
+	// Modules are registered when missing or changed, and removed when not referenced anymore.
+	// A change of a referenced ConfigMap triggers a reconcile of the cluster.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="UDF Modules"
+	// +optional
+	UDFModules []UDFModuleSource `json:"udfModules,omitempty"`
+
+	// UpgradeStrategy controls how the pods are upgraded when the image is changed.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Upgrade Strategy"
+	// +optional
+	UpgradeStrategy *UpgradeStrategySpec `json:"upgradeStrategy,omitempty"`
+
+	// MaintenanceWindows are the time windows in which the disruptive changes are applied to the cluster.
+	// Pod restarts, e.g. for image or config changes, scale-downs and rack deletions, which delete the storage
+	// of the removed pods, are deferred until a window opens.
+	// Non-disruptive changes like dynamic config, access control and services are applied immediately.
+	// Failed pods are restarted irrespective of the windows.
+	// If not given, disruptive changes are applied immediately.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maintenance Windows"
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// MaintenanceWindow is a recurring time window in which disruptive changes are allowed.
+type MaintenanceWindow struct {
+	// Schedule is the start of the window in the standard 5-field cron format
+	// "minute hour day-of-month month day-of-week", e.g. "0 2 * * 6" for every Saturday at 02:00.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Duration is the length of the window.
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA time zone name of the schedule, e.g. "Europe/Berlin". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+type DisruptiveWorkType string
+
+const (
+	// DisruptiveWorkPodRestart is the restart of pods, e.g. for an image or config change.
+	DisruptiveWorkPodRestart DisruptiveWorkType = "PodRestart"
+
+	// DisruptiveWorkScaleDown is the removal of pods from a rack, along with their storage.
+	DisruptiveWorkScaleDown DisruptiveWorkType = "ScaleDown"
+
+	// DisruptiveWorkRackDeletion is the deletion of a rack removed from the spec.
+	DisruptiveWorkRackDeletion DisruptiveWorkType = "RackDeletion"
+)
+
+// DisruptiveWork is a disruptive change deferred until the next maintenance window.
+type DisruptiveWork struct {
+	// Type is the type of the disruptive change.
+	Type DisruptiveWorkType `json:"type"`
+
+	// RackID is the rack the change applies to.
+	RackID int `json:"rackID"`
+
+	// Pods are the pods to be restarted.
+	// +optional
+	Pods []string `json:"pods,omitempty"`
+}
+
+// MaintenanceWindowStatus is the state of the disruptive changes with respect to spec.maintenanceWindows.
+type MaintenanceWindowStatus struct {
+	// NextWindowStart is the start time of the next maintenance window.
+	// +optional
+	NextWindowStart *metav1.Time `json:"nextWindowStart,omitempty"`
+
+	// PendingWork is the disruptive work waiting for the next maintenance window.
+	// +optional
+	PendingWork []DisruptiveWork `json:"pendingWork,omitempty"`
+}
+
+// ScaleDownSelection selects the pods to remove in a scale-down.
+type ScaleDownSelection struct {
+	// PodNames are the names of the pods to remove.
+	// +optional
+	PodNames []string `json:"podNames,omitempty"`
+
+	// K8sNodeNames are the Kubernetes nodes whose pods are removed. Pods are not scheduled on these nodes while
+	// they are listed.
+	// +optional
+	K8sNodeNames []string `json:"k8sNodeNames,omitempty"`
+}
+
+// ScaleDownSelectionStatus is the state of the pods selected in spec.scaleDownSelection.
+type ScaleDownSelectionStatus struct {
+	// PodK8sNodes maps each pod of spec.scaleDownSelection.podNames to the Kubernetes node it was running on when
+	// it was selected. Pods are not scheduled on these nodes while the pods are selected.
+	// +optional
+	PodK8sNodes map[string]string `json:"podK8sNodes,omitempty"`
+}
+
+type ReconcilePolicy string
+
+const (
+	// ReconcilePolicyApply applies the changes in the spec to the cluster.
+	ReconcilePolicyApply ReconcilePolicy = "Apply"
+
+	// ReconcilePolicyPlanOnly computes the changes needed to reach the spec without applying them.
+	ReconcilePolicyPlanOnly ReconcilePolicy = "PlanOnly"
+)
+
+// RefreshServerHealthWhilePausedAnnotation is the AerospikeCluster annotation used to keep refreshing the server
+// health snapshot while the reconciliation is paused. Its value should be "true".
+const RefreshServerHealthWhilePausedAnnotation = "asdb.aerospike.com/refresh-server-health-while-paused"
+
+// ServerHealthSpec configures the periodic health snapshot of the Aerospike server.
+type ServerHealthSpec struct {
+	// RefreshPeriod is the period at which the server health snapshot is refreshed in status.
+	// It should be at least 10 seconds. Default is 60 seconds.
+	// +optional
+	RefreshPeriod metav1.Duration `json:"refreshPeriod,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Memory;Device;Index
+type AutoscalingMetric string
+
+const (
+	// AutoscalingMetricMemory is the memory usage of the namespaces.
+	AutoscalingMetricMemory AutoscalingMetric = "Memory"
+
+	// AutoscalingMetricDevice is the device usage of the namespaces.
+	AutoscalingMetricDevice AutoscalingMetric = "Device"
+
+	// AutoscalingMetricIndex is the primary index usage of the namespaces with a flash or pmem index.
+	AutoscalingMetricIndex AutoscalingMetric = "Index"
+)
+
+// AutoscalingSpec configures the scaling of the cluster size based on the namespace usage.
+// The usage is the highest usage of the given metrics across the namespaces and the nodes.
+type AutoscalingSpec struct { //nolint:govet // for readability
+	// MinSize is the minimum size of the cluster.
+	// +kubebuilder:validation:Minimum=1
+	MinSize int32 `json:"minSize"`
+
+	// MaxSize is the maximum size of the cluster.
+	// +kubebuilder:validation:Minimum=1
+	MaxSize int32 `json:"maxSize"`
+
+	// Metrics are the usages compared with the watermarks. Defaults to all the metrics.
+	// +optional
+	Metrics []AutoscalingMetric `json:"metrics,omitempty"`
+
+	// HighWatermarkPct is the usage percentage at or above which the cluster is scaled up. Defaults to 80.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	HighWatermarkPct int32 `json:"highWatermarkPct,omitempty"`
+
+	// LowWatermarkPct is the usage percentage below which the cluster is scaled down. Defaults to 40.
+	// The cluster is not scaled down if the usage after the scale-down would reach the high watermark.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	LowWatermarkPct int32 `json:"lowWatermarkPct,omitempty"`
+
+	// StepSize is the number of pods added or removed in a scaling. Defaults to the number of racks, so that the
+	// pods stay evenly distributed across the racks.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	StepSize int32 `json:"stepSize,omitempty"`
+
+	// ScaleUpCooldown is the minimum time between the last scaling and a scale-up. Defaults to 5 minutes.
+	// +optional
+	ScaleUpCooldown *metav1.Duration `json:"scaleUpCooldown,omitempty"`
+
+	// ScaleDownCooldown is the minimum time between the last scaling and a scale-down. Defaults to 30 minutes.
+	// +optional
+	ScaleDownCooldown *metav1.Duration `json:"scaleDownCooldown,omitempty"`
+}
+
+// AutoscalingStatus is the state of the autoscaling of the cluster.
+type AutoscalingStatus struct {
+	// UsagePct is the usage percentage at the last evaluation.
+	// +optional
+	UsagePct *int32 `json:"usagePct,omitempty"`
+
+	// LastScaleTime is the time when spec.size was last changed by the autoscaling.
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// LastDecision is the decision taken at the last evaluation.
+	// +optional
+	LastDecision string `json:"lastDecision,omitempty"`
+}
+
+// StorageClassMigrationSpec configures the migration of persistent volumes to a new storage class.
+type StorageClassMigrationSpec struct {
+	// Paused stops the migration after the pod being migrated.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// StorageClassMigrationPhase is the phase of the storage class migration.
+// +kubebuilder:validation:Enum=InProgress;Paused;Completed
+type StorageClassMigrationPhase string
+
+const (
+	StorageClassMigrationInProgress StorageClassMigrationPhase = "InProgress"
+	StorageClassMigrationPaused     StorageClassMigrationPhase = "Paused"
+	StorageClassMigrationCompleted  StorageClassMigrationPhase = "Completed"
+)
+
+// StorageClassMigrationStatus is the progress of the storage class migration.
+type StorageClassMigrationStatus struct {
+	// Phase is the phase of the migration.
+	Phase StorageClassMigrationPhase `json:"phase"`
+
+	// CurrentPod is the pod whose volumes were last replaced.
+	// +optional
+	CurrentPod string `json:"currentPod,omitempty"`
+
+	// PendingPods are the pods having volumes of an old storage class.
+	// +optional
+	PendingPods []string `json:"pendingPods,omitempty"`
+}
+
+// VolumeExpansionState is the state of the resize of a persistent volume claim.
+// +kubebuilder:validation:Enum=Resizing;FileSystemResizePending
+type VolumeExpansionState string
+
+const (
+	// VolumeExpansionResizing means the volume is being resized by the storage provider.
+	VolumeExpansionResizing VolumeExpansionState = "Resizing"
+	// VolumeExpansionFileSystemResizePending means the volume is resized and the file system is waiting to be
+	// resized on the node.
+	VolumeExpansionFileSystemResizePending VolumeExpansionState = "FileSystemResizePending"
+)
+
+// VolumeExpansionStatus is the state of the resize of a persistent volume claim.
+type VolumeExpansionStatus struct { //nolint:govet // for readability
+	// PVCName is the name of the persistent volume claim.
+	PVCName string `json:"pvcName"`
+
+	// PodName is the name of the pod using the persistent volume claim.
+	PodName string `json:"podName"`
+
+	// RequestedSize is the size requested for the persistent volume claim.
+	RequestedSize resource.Quantity `json:"requestedSize"`
+
+	// Capacity is the current capacity of the persistent volume claim.
+	// +optional
+	Capacity *resource.Quantity `json:"capacity,omitempty"`
+
+	// State is the state of the resize.
+	State VolumeExpansionState `json:"state"`
+
+	// LastTransitionTime is the time when the resize entered its current state.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+type SecondaryIndexType string
+
+const (
+	SecondaryIndexTypeNumeric     SecondaryIndexType = "Numeric"
+	SecondaryIndexTypeString      SecondaryIndexType = "String"
+	SecondaryIndexTypeGeo2DSphere SecondaryIndexType = "Geo2DSphere"
+)
+
+type SecondaryIndexCollectionType string
+
+const (
+	SecondaryIndexCollectionTypeDefault   SecondaryIndexCollectionType = "Default"
+	SecondaryIndexCollectionTypeList      SecondaryIndexCollectionType = "List"
+	SecondaryIndexCollectionTypeMapKeys   SecondaryIndexCollectionType = "MapKeys"
+	SecondaryIndexCollectionTypeMapValues SecondaryIndexCollectionType = "MapValues"
+)
+
+// SecondaryIndexSpec is a secondary index on a bin of a namespace or set.
+// Secondary indexes cannot be modified, an index should be removed and added with another name instead.
+type SecondaryIndexSpec struct {
+	// Name is the name of the index. It should be unique in the namespace.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the indexed records. It should be present in aerospikeConfig.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Set is the set of the indexed records. If not given, the records of the whole namespace are indexed.
+	// +optional
+	Set string `json:"set,omitempty"`
+
+	// Bin is the indexed bin.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=15
+	Bin string `json:"bin"`
+
+	// Type is the type of the indexed values.
+	// +kubebuilder:validation:Enum=Numeric;String;Geo2DSphere
+	Type SecondaryIndexType `json:"type"`
+
+	// CollectionType is the type of the collection whose elements are indexed. Default indexes the bin value.
+	// +kubebuilder:validation:Enum=Default;List;MapKeys;MapValues
+	// +optional
+	CollectionType SecondaryIndexCollectionType `json:"collectionType,omitempty"`
+
+	// Context is the path to the nested collection element indexed within a CDT bin.
+	// +optional
+	Context []CDTContextSpec `json:"context,omitempty"`
+}
+
+type CDTContextType string
+
+const (
+	CDTContextTypeListIndex CDTContextType = "ListIndex"
+	CDTContextTypeListRank  CDTContextType = "ListRank"
+	CDTContextTypeListValue CDTContextType = "ListValue"
+	CDTContextTypeMapIndex  CDTContextType = "MapIndex"
+	CDTContextTypeMapRank   CDTContextType = "MapRank"
+	CDTContextTypeMapKey    CDTContextType = "MapKey"
+	CDTContextTypeMapValue  CDTContextType = "MapValue"
+)
+
+// CDTContextSpec is a step of the path to a nested element of a collection data type bin.
+type CDTContextSpec struct {
+	// Type is the way the nested element is looked up.
+	// +kubebuilder:validation:Enum=ListIndex;ListRank;ListValue;MapIndex;MapRank;MapKey;MapValue
+	Type CDTContextType `json:"type"`
+
+	// Value is the index, rank, key or value used to look up the nested element.
+	// It should be an integer for index and rank lookups.
+	Value intstr.IntOrString `json:"value"`
+}
+
+type SecondaryIndexPhase string
+
+const (
+	// SecondaryIndexBuilding means the index is created and is being built on the nodes.
+	SecondaryIndexBuilding SecondaryIndexPhase = "Building"
+
+	// SecondaryIndexReady means the index is built on all the nodes.
+	SecondaryIndexReady SecondaryIndexPhase = "Ready"
+
+	// SecondaryIndexFailed means the index could not be created.
+	SecondaryIndexFailed SecondaryIndexPhase = "Failed"
+)
+
+// SecondaryIndexStatus is the status of a secondary index managed by the operator.
+type SecondaryIndexStatus struct { //nolint:govet // for readability
+	// Name is the name of the index.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the index.
+	Namespace string `json:"namespace"`
+
+	// Set is the set of the index.
+	// +optional
+	Set string `json:"set,omitempty"`
+
+	// Bin is the indexed bin.
+	Bin string `json:"bin"`
+
+	// Phase is the state of the index.
+	// +kubebuilder:validation:Enum=Building;Ready;Failed
+	Phase SecondaryIndexPhase `json:"phase"`
+
+	// LoadPct is the build progress of the index. It is the lowest percentage across the nodes.
+	// +optional
+	LoadPct int32 `json:"loadPct,omitempty"`
+
+	// Message has the details of the failure, if any.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// UDFModuleSource is a ConfigMap having Lua UDF modules.
+type UDFModuleSource struct {
+	// ConfigMapName is the name of the ConfigMap in the namespace of the cluster.
+	// +kubebuilder:validation:MinLength=1
+	ConfigMapName string `json:"configMapName"`
+
+	// Modules is the list of ConfigMap keys to be registered. Each key is registered as a module with the key as
+	// module name. If not given, all the keys ending with .lua are registered.
+	// +optional
+	Modules []string `json:"modules,omitempty"`
+}
+
+type UDFModulePhase string
+
+const (
+	// UDFModuleRegistered means the module is registered with the content of the ConfigMap.
+	UDFModuleRegistered UDFModulePhase = "Registered"
+
+	// UDFModuleFailed means the module could not be registered.
+	UDFModuleFailed UDFModulePhase = "Failed"
+)
+
+// UDFModuleStatus is the status of a UDF module managed by the operator.
+type UDFModuleStatus struct { //nolint:govet // for readability
+	// Name is the name of the module.
+	Name string `json:"name"`
+
+	// ConfigMapName is the name of the ConfigMap having the module.
+	ConfigMapName string `json:"configMapName"`
+
+	// Hash is the hash of the module content registered in the server.
+	// +optional
+	Hash string `json:"hash,omitempty"`
+
+	// Phase is the state of the module.
+	// +kubebuilder:validation:Enum=Registered;Failed
+	Phase UDFModulePhase `json:"phase"`
+
+	// Message has the details of the failure, if any.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CanaryApprovalAnnotation is the AerospikeCluster annotation used to approve the canary upgrade.
+// Its value should be the image being upgraded to.
+const CanaryApprovalAnnotation = "asdb.aerospike.com/canary-approved"
+
+// UpgradeStrategySpec controls how the pods are upgraded when the image is changed.
+type UpgradeStrategySpec struct {
+	// Canary upgrades a few pods first, and upgrades the remaining pods only after the canary pods have soaked
+	// and passed the health gates.
+	// +optional
+	Canary *CanaryUpgradeSpec `json:"canary,omitempty"`
+
+	// Rollback restores the last known-good image in spec.image if the upgrade to a new image fails.
+	// +optional
+	Rollback *UpgradeRollbackSpec `json:"rollback,omitempty"`
+}
+
+// UpgradeRollbackSpec configures when a failed image upgrade is rolled back.
+type UpgradeRollbackSpec struct {
+	// Timeout is the time after which the upgrade is rolled back if it has not completed.
+	// If not given, the upgrade is rolled back only based on failedPodsThreshold.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// FailedPodsThreshold is the number of pods failing on the new image, e.g. crashing or failing to pull the image,
+	// after which the upgrade is rolled back. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailedPodsThreshold int32 `json:"failedPodsThreshold,omitempty"`
+}
+
+// CanaryUpgradeSpec configures the canary pods of an image upgrade and the gates to pass before the remaining
+// pods are upgraded. Health gates are no dead or unavailable partitions, no pending migrations, all pods ready and
+// the given info command checks.
+type CanaryUpgradeSpec struct { //nolint:govet // for readability
+	// Pods is the number or percentage of the cluster pods upgraded first.
+	// Pods are picked from the racks in rackConfig order. Defaults to 1. Cannot be given along with rackID.
+	// +optional
+	Pods *intstr.IntOrString `json:"pods,omitempty"`
+
+	// RackID is the rack whose pods are upgraded first. Cannot be given along with pods.
+	// +optional
+	RackID *int `json:"rackID,omitempty"`
+
+	// SoakDuration is the time for which the canary pods run the new image before the health gates are verified.
+	// +optional
+	SoakDuration metav1.Duration `json:"soakDuration,omitempty"`
+
+	// RequireApproval pauses the upgrade after the canary pods pass the health gates, until the
+	// asdb.aerospike.com/canary-approved annotation of the AerospikeCluster is set to the new image.
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
+
+	// InfoChecks are the additional info command checks run on the canary pods as health gates.
+	// +optional
+	InfoChecks []InfoCommandCheck `json:"infoChecks,omitempty"`
+}
+
+// InfoCommandCheck is an assertion on the response of an Aerospike info command.
+type InfoCommandCheck struct {
+	// Command is the info command, e.g. statistics or namespace/test.
+	// +kubebuilder:validation:MinLength=1
+	Command string `json:"command"`
+
+	// Key is the key checked in the response having ";" separated "key=value" pairs.
+	// If not given, the whole response is checked.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Value is the expected value.
+	Value string `json:"value"`
+}
+
+// ImageUpgradeStatus is the state of an ongoing image upgrade.
+type ImageUpgradeStatus struct {
+	// StartTime is the time when the upgrade started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// Image is the image being upgraded to.
+	Image string `json:"image"`
+
+	// LastKnownGoodImage is the image the cluster was running successfully before the upgrade.
+	LastKnownGoodImage string `json:"lastKnownGoodImage"`
+}
+
+// UpgradeRollbackStatus is the rollback of a failed image upgrade.
+type UpgradeRollbackStatus struct {
+	// Time is the time when the upgrade was rolled back.
+	Time metav1.Time `json:"time"`
+
+	// FailedImage is the image of the failed upgrade.
+	FailedImage string `json:"failedImage"`
+
+	// Image is the last known-good image restored in spec.image.
+	Image string `json:"image"`
+
+	// Reason is the reason of the upgrade failure.
+	Reason string `json:"reason"`
+}
+
+type CanaryStage string
+
+const (
+	// CanaryStageUpgrading means the canary pods are being upgraded.
+	CanaryStageUpgrading CanaryStage = "Upgrading"
+
+	// CanaryStageSoaking means the canary pods are upgraded and running the new image for the soak duration.
+	CanaryStageSoaking CanaryStage = "Soaking"
+
+	// CanaryStageVerifying means the health gates are being verified.
+	CanaryStageVerifying CanaryStage = "Verifying"
+
+	// CanaryStageAwaitingApproval means the health gates have passed and the upgrade waits for approval.
+	CanaryStageAwaitingApproval CanaryStage = "AwaitingApproval"
+
+	// CanaryStageCompleted means the canary has passed and the remaining pods are being upgraded.
+	CanaryStageCompleted CanaryStage = "Completed"
+)
+
+// CanaryStatus is the state of the canary upgrade of the cluster.
+type CanaryStatus struct { //nolint:govet // for readability
+	// Image is the image being upgraded to.
+	Image string `json:"image"`
+
+	// Stage is the current stage of the canary upgrade.
+	// +kubebuilder:validation:Enum=Upgrading;Soaking;Verifying;AwaitingApproval;Completed
+	Stage CanaryStage `json:"stage"`
+
+	// Pods are the names of the canary pods.
+	// +optional
+	Pods []string `json:"pods,omitempty"`
+
+	// SoakStartTime is the time when all the canary pods were upgraded.
+	// +optional
+	SoakStartTime *metav1.Time `json:"soakStartTime,omitempty"`
+
+	// Message has the details of the current stage, e.g. the health gates not passed yet.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 type OperationKind string
@@ -175,11 +835,22 @@ const (
 
 	// OperationPodRestart is the on-demand operation that leads to the restart of aerospike pods.
 	OperationPodRestart OperationKind = "PodRestart"
+
+	// OperationQuiesce is the on-demand operation that quiesces the aerospike pods.
+	// The pods stay quiesced as long as the operation is present in the spec,
+	// quiesce is undone once the operation is removed.
+	OperationQuiesce OperationKind = "Quiesce"
+
+	// OperationRecluster is the on-demand operation that runs recluster on the aerospike cluster.
+	OperationRecluster OperationKind = "Recluster"
+
+	// OperationTruncate is the on-demand operation that truncates a namespace or a set of the aerospike cluster.
+	OperationTruncate OperationKind = "Truncate"
 )
 
-type OperationSpec struct {
+type OperationSpec struct { //nolint:govet // for readability
 	// Kind is the type of operation to be performed on the Aerospike cluster.
-	// +kubebuilder:validation:Enum=WarmRestart;PodRestart
+	// +kubebuilder:validation:Enum=WarmRestart;PodRestart;Quiesce;Recluster;Truncate
 	Kind OperationKind `json:"kind"`
 
 	// ID is the unique identifier for the operation. It is used by the operator to track the operation.
@@ -188,8 +859,86 @@ type OperationSpec struct {
 	ID string `json:"id"`
 
 	// PodList is the list of pods on which the operation is to be performed.
+	// If not given, the operation is performed on all the pods. For Quiesce operation, it quiesces all the
+	// pods of the cluster. It is not allowed for Recluster and Truncate operations.
 	// +optional
 	PodList []string `json:"podList,omitempty"`
+
+	// Truncate is the namespace or set to be truncated. It is required for Truncate operation.
+	// +optional
+	Truncate *TruncateSpec `json:"truncate,omitempty"`
+}
+
+// TruncateSpec is the namespace or set truncated by the Truncate operation.
+type TruncateSpec struct { //nolint:govet // for readability
+	// Namespace is the name of the namespace to be truncated. It should be present in aerospikeConfig.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Set is the name of the set to be truncated. If not given, the whole namespace is truncated.
+	// +optional
+	Set string `json:"set,omitempty"`
+
+	// LastUpdateTime is the truncate cutoff. Only the records last updated before this time are deleted.
+	// If not given, the time when the operation is first executed is used as the cutoff, and recorded in
+	// status.operationHistory.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+type OperationPhase string
+
+const (
+	// OperationPending means the operation is waiting for the operations before it in the queue.
+	OperationPending OperationPhase = "Pending"
+
+	// OperationRunning means the operation is being executed.
+	OperationRunning OperationPhase = "Running"
+
+	// OperationSucceeded means the operation is executed on all its pods.
+	OperationSucceeded OperationPhase = "Succeeded"
+
+	// OperationFailed means the operation failed or was removed before completion.
+	// A failed operation that is still in the spec is retried in the next reconcile.
+	OperationFailed OperationPhase = "Failed"
+)
+
+// OperationStatus is the execution status of an on-demand operation.
+type OperationStatus struct { //nolint:govet // for readability
+	// ID is the unique identifier of the operation.
+	ID string `json:"id"`
+
+	// Kind is the type of the operation.
+	Kind OperationKind `json:"kind"`
+
+	// Phase is the execution phase of the operation.
+	// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed
+	Phase OperationPhase `json:"phase"`
+
+	// StartTime is the time when the operation started running.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time when the operation succeeded or was removed before completion.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// PodsCompleted is the list of pods on which the operation has been performed.
+	// +optional
+	PodsCompleted []string `json:"podsCompleted,omitempty"`
+
+	// Result has the outcome of the operations that are executed using info commands, e.g. Truncate.
+	// +optional
+	Result string `json:"result,omitempty"`
+
+	// TruncateLastUpdateTime is the cutoff of a Truncate operation without lastUpdateTime. It is recorded before
+	// the truncate is sent, so that a retry of the operation truncates at the same cutoff.
+	// +optional
+	TruncateLastUpdateTime *metav1.Time `json:"truncateLastUpdateTime,omitempty"`
+
+	// Message has the details of the failure, if any.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 type SeedsFinderServices struct {
@@ -461,6 +1210,76 @@ type RackConfig struct { //nolint:govet // for readability
 	// This makes sure that later on, all pods are properly counted when evaluating the cluster stability.
 	// +optional
 	MaxIgnorablePods *intstr.IntOrString `json:"maxIgnorablePods,omitempty"`
+
+	// RollingUpdateStrategy controls the order of racks and the pause between them in the rolling operations like
+	// rolling restart, upgrade and scale-down. Racks are updated one at a time.
+	// +optional
+	RollingUpdateStrategy *RackRollingUpdateStrategy `json:"rollingUpdateStrategy,omitempty"`
+
+	// RollingUpdatePolicy specifies the wait and the verification between the batches of the rolling operations
+	// like rolling restart and upgrade.
+	// +optional
+	RollingUpdatePolicy *RackRollingUpdatePolicy `json:"rollingUpdatePolicy,omitempty"`
+
+	// RebalancePolicy enables the gradual rebalancing of pods between racks. When racks are added or removed, or
+	// the cluster is scaled, the rack sizes are changed in small steps, each step waiting for the migrations of
+	// the previous one to complete. Racks are resized at once if not set.
+	// +optional
+	RebalancePolicy *RackRebalancePolicy `json:"rebalancePolicy,omitempty"`
+}
+
+// RackRebalancePolicy specifies the steps in which pods are moved between racks.
+type RackRebalancePolicy struct {
+	// MaxPodsPerStep is the maximum number of pods added and the maximum number of pods removed in a step.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxPodsPerStep int32 `json:"maxPodsPerStep,omitempty"`
+
+	// MaxDataInMotion is the maximum amount of data migrated in a step. The data of a pod is estimated as the
+	// average data used per node across all the namespaces. A step moves at least one pod.
+	// +optional
+	MaxDataInMotion *resource.Quantity `json:"maxDataInMotion,omitempty"`
+}
+
+// RackRollingUpdateStrategy specifies how racks are processed in the rolling operations.
+type RackRollingUpdateStrategy struct { //nolint:govet // for readability
+	// RackOrder is the list of rack IDs in the order in which racks are updated.
+	// Racks not in the list are updated after the listed racks, in the order of rackConfig.racks.
+	// +optional
+	RackOrder []int `json:"rackOrder,omitempty"`
+
+	// PauseAfterRack is the time to wait after a rack is updated before updating the next racks.
+	// There is no pause after the last rack in the update order.
+	// +optional
+	PauseAfterRack *metav1.Duration `json:"pauseAfterRack,omitempty"`
+}
+
+// RollingUpdatePauseStatus is the pause of the rolling operation after a rack is updated.
+type RollingUpdatePauseStatus struct {
+	// RackID is the rack updated before the pause.
+	RackID int `json:"rackID"`
+
+	// PausedUntil is the time until which the next racks are not updated.
+	PausedUntil metav1.Time `json:"pausedUntil"`
+}
+
+// RackRollingUpdatePolicy specifies the gates to pass before the next batch of pods is restarted in the rolling
+// operations. Failed pods are restarted without waiting for these gates.
+type RackRollingUpdatePolicy struct { //nolint:govet // for readability
+	// MinReadySeconds is the minimum number of seconds for which the pods must be ready before the next batch
+	// is restarted. It lets the client latencies settle after a batch is restarted.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+
+	// WaitForMigrations waits for the migrations to complete before the next batch is restarted.
+	// +optional
+	WaitForMigrations bool `json:"waitForMigrations,omitempty"`
+
+	// PostBatchChecks are the info command checks to pass on all the ready pods before the next batch is restarted.
+	// +optional
+	PostBatchChecks []InfoCommandCheck `json:"postBatchChecks,omitempty"`
 }
 
 // Rack specifies single rack config
@@ -742,6 +1561,28 @@ type VolumeSource struct {
 	// +optional
 	ConfigMap *corev1.ConfigMapVolumeSource `json:"configMap,omitempty" protobuf:"bytes,19,opt,name=configMap"`
 
+	// Projected represents a projection of secrets, configMaps, downward API and service account tokens into a
+	// single directory, e.g. to combine a TLS certificate secret with a CA bundle.
+	// +optional
+	Projected *corev1.ProjectedVolumeSource `json:"projected,omitempty" protobuf:"bytes,26,opt,name=projected"`
+
+	// CSI represents an ephemeral volume provided by an inline CSI driver, e.g. the secrets store CSI driver.
+	// More info: https://kubernetes.io/docs/concepts/storage/ephemeral-volumes/#csi-ephemeral-volumes
+	// +optional
+	CSI *corev1.CSIVolumeSource `json:"csi,omitempty" protobuf:"bytes,28,opt,name=csi"`
+
+	// Ephemeral represents a volume provisioned by a storage driver from a PVC template. The PVC shares the pod's
+	// lifetime, so the volume is lost when the pod is deleted or restarted with a new spec.
+	// More info: https://kubernetes.io/docs/concepts/storage/ephemeral-volumes/#generic-ephemeral-volumes
+	// +optional
+	Ephemeral *corev1.EphemeralVolumeSource `json:"ephemeral,omitempty" protobuf:"bytes,29,opt,name=ephemeral"`
+
+	// HostPath represents a pre-existing file or directory on the host machine that is directly exposed to the
+	// container. It is meant for bare-metal layouts where the pods are pinned to their nodes.
+	// More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath
+	// +optional
+	HostPath *corev1.HostPathVolumeSource `json:"hostPath,omitempty" protobuf:"bytes,1,opt,name=hostPath"`
+
 	// +optional
 	PersistentVolume *PersistentVolumeSpec `json:"persistentVolume,omitempty"`
 }
@@ -790,6 +1631,11 @@ type AerospikeStorageSpec struct { //nolint:govet // for readability
 	// +optional
 	LocalStorageClasses []string `json:"localStorageClasses,omitempty"`
 
+	// LocalPVRecovery contains the policy to recover the pods which cannot be scheduled because their local volumes
+	// are on a node that is lost.
+	// +optional
+	LocalPVRecovery *LocalPVRecoverySpec `json:"localPVRecovery,omitempty"`
+
 	// Volumes list to attach to created pods.
 	// +patchMergeKey=name
 	// +patchStrategy=merge
@@ -799,6 +1645,19 @@ type AerospikeStorageSpec struct { //nolint:govet // for readability
 	Volumes []VolumeSpec `json:"volumes,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 }
 
+// LocalPVRecoverySpec is the policy to recover the pods stuck on the local volumes of a lost node.
+type LocalPVRecoverySpec struct {
+	// Enabled deletes the local PVCs and the pod when the pod is unschedulable because the node affinity of its local
+	// volumes points to a node that is missing or NotReady. The pod is then rescheduled on another node, and its
+	// volumes are re-initialized there.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// GracePeriod is the minimum time a pod is unschedulable before its local PVCs are deleted. Defaults to 10 minutes.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
 // AerospikeClusterStatusSpec captures the current status of the cluster.
 type AerospikeClusterStatusSpec struct { //nolint:govet // for readability
 	// Aerospike cluster size
@@ -916,8 +1775,102 @@ type AerospikeClusterStatus struct { //nolint:govet // for readability
 	// The current state of Aerospike cluster.
 	AerospikeClusterStatusSpec `json:",inline"`
 
-	// Details about the current condition of the AerospikeCluster resource.
-	// Conditions []apiextensions.CustomResourceDefinitionCondition `json:"conditions"`
+	// Conditions represent the latest available observations of the AerospikeCluster's state.
+	// Known condition types are Available, Progressing, Degraded, MigrationsPending, ACLReconciled, RosterApplied
+	// and ConfigDriftDetected.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// ObservedGeneration is the most recent generation of the AerospikeCluster reconciled by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastReconcileTime is the time when the last reconcile of the AerospikeCluster ended.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// LastSuccessfulReconcileTime is the time when the last reconcile of the AerospikeCluster completed
+	// successfully.
+	// +optional
+	LastSuccessfulReconcileTime *metav1.Time `json:"lastSuccessfulReconcileTime,omitempty"`
+
+	// LastReconcileError is the error returned by the last reconcile of the AerospikeCluster.
+	// It is cleared after a successful reconcile.
+	// +optional
+	LastReconcileError string `json:"lastReconcileError,omitempty"`
+
+	// Racks has the current state of each rack of the cluster, including the racks being deleted.
+	// +listType=map
+	// +listMapKey=id
+	// +optional
+	Racks []RackStatus `json:"racks,omitempty"`
+
+	// ServerHealth is the latest health snapshot of the Aerospike server.
+	// It is refreshed periodically only if spec.serverHealth is set.
+	// +optional
+	ServerHealth *ServerHealthStatus `json:"serverHealth,omitempty"`
+
+	// Autoscaling is the state of the autoscaling configured in spec.autoscaling.
+	// +optional
+	Autoscaling *AutoscalingStatus `json:"autoscaling,omitempty"`
+
+	// VolumeExpansions is the state of the persistent volume claims being resized after an increase of the size of
+	// their volume. A persistent volume claim is removed from the list once it is resized.
+	// +listType=map
+	// +listMapKey=pvcName
+	// +optional
+	VolumeExpansions []VolumeExpansionStatus `json:"volumeExpansions,omitempty"`
+
+	// StorageClassMigration is the progress of the storage class migration configured in spec.storageClassMigration.
+	// +optional
+	StorageClassMigration *StorageClassMigrationStatus `json:"storageClassMigration,omitempty"`
+
+	// OperationHistory has the execution status of the on-demand operations, in the order they were submitted.
+	// Only the latest completed operations are retained.
+	// +optional
+	OperationHistory []OperationStatus `json:"operationHistory,omitempty"`
+
+	// Plan is the set of changes needed to reach the desired spec.
+	// It is computed only if spec.reconcilePolicy is PlanOnly.
+	// +optional
+	Plan *ReconcilePlan `json:"plan,omitempty"`
+
+	// SecondaryIndexes is the status of the secondary indexes managed by the operator.
+	// +optional
+	SecondaryIndexes []SecondaryIndexStatus `json:"secondaryIndexes,omitempty"`
+
+	// UDFModules is the status of the UDF modules managed by the operator.
+	// +optional
+	UDFModules []UDFModuleStatus `json:"udfModules,omitempty"`
+
+	// Canary is the state of the canary upgrade. It is set only if spec.upgradeStrategy.canary is given.
+	// +optional
+	Canary *CanaryStatus `json:"canary,omitempty"`
+
+	// Upgrade is the state of the ongoing image upgrade. It is set only if spec.upgradeStrategy.rollback is given.
+	// +optional
+	Upgrade *ImageUpgradeStatus `json:"upgrade,omitempty"`
+
+	// UpgradeRollback is the last rollback of a failed image upgrade. It is cleared once spec.image is changed.
+	// +optional
+	UpgradeRollback *UpgradeRollbackStatus `json:"upgradeRollback,omitempty"`
+
+	// RollingUpdatePause is set while the rolling operation is paused after a rack is updated, as configured by
+	// spec.rackConfig.rollingUpdateStrategy.pauseAfterRack.
+	// +optional
+	RollingUpdatePause *RollingUpdatePauseStatus `json:"rollingUpdatePause,omitempty"`
+
+	// MaintenanceWindow has the disruptive work deferred until the next window of spec.maintenanceWindows.
+	// +optional
+	MaintenanceWindow *MaintenanceWindowStatus `json:"maintenanceWindow,omitempty"`
+
+	// ScaleDownSelection is the state of the pods selected for removal in spec.scaleDownSelection.
+	// +optional
+	ScaleDownSelection *ScaleDownSelectionStatus `json:"scaleDownSelection,omitempty"`
 
 	// Pods has Aerospike specific status of the pods.
 	// This is map instead of the conventional map as list convention to allow each pod to patch update its own
@@ -935,6 +1888,250 @@ type AerospikeClusterStatus struct { //nolint:govet // for readability
 	Selector string `json:"selector,omitempty"`
 }
 
+// PodRestartType is the kind of restart a pod is waiting for.
+// +kubebuilder:validation:Enum=PodRestart;QuickRestart;UpdateConf
+type PodRestartType string
+
+const (
+	// PodRestartTypePodRestart means the pod will be deleted and recreated.
+	PodRestartTypePodRestart PodRestartType = "PodRestart"
+
+	// PodRestartTypeQuickRestart means only the Aerospike server in the pod will be restarted.
+	PodRestartTypeQuickRestart PodRestartType = "QuickRestart"
+
+	// PodRestartTypeUpdateConf means the Aerospike config will be updated dynamically without any restart.
+	PodRestartTypeUpdateConf PodRestartType = "UpdateConf"
+)
+
+// PodPendingRestart is a pod waiting to be restarted to pick up the desired spec.
+type PodPendingRestart struct {
+	// PodName is the name of the pod.
+	PodName string `json:"podName"`
+
+	// RestartType is the kind of restart the pod is waiting for.
+	RestartType PodRestartType `json:"restartType"`
+}
+
+// RackStatus contains the current state of a rack.
+type RackStatus struct { //nolint:govet // for readability
+	// ID is the rack identifier.
+	ID int `json:"id"`
+
+	// Zone is the K8s zone of the rack pods.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// Region is the K8s region of the rack pods.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// DesiredPods is the number of pods this rack should have.
+	DesiredPods int32 `json:"desiredPods"`
+
+	// CurrentPods is the number of pods currently in the rack.
+	CurrentPods int32 `json:"currentPods"`
+
+	// ReadyPods is the number of running and ready pods in the rack.
+	ReadyPods int32 `json:"readyPods"`
+
+	// PodsPendingRestart has the pods of the rack waiting to be restarted along with the restart type.
+	// +optional
+	PodsPendingRestart []PodPendingRestart `json:"podsPendingRestart,omitempty"`
+
+	// CurrentRevision is the StatefulSet revision used to create the current rack pods.
+	// +optional
+	CurrentRevision string `json:"currentRevision,omitempty"`
+
+	// UpdateRevision is the StatefulSet revision the rack pods are being updated to.
+	// +optional
+	UpdateRevision string `json:"updateRevision,omitempty"`
+
+	// Deleting is true if the rack has been removed from the spec and is being deleted.
+	// +optional
+	Deleting bool `json:"deleting,omitempty"`
+}
+
+// ServerHealthStatus is a snapshot of the Aerospike server health as reported by the cluster nodes.
+type ServerHealthStatus struct { //nolint:govet // for readability
+	// LastRefreshTime is the time when the snapshot was taken.
+	LastRefreshTime metav1.Time `json:"lastRefreshTime"`
+
+	// Error is the error faced while taking the snapshot, if any.
+	// The snapshot may be partial in case of an error.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// NodesResponded is the number of Aerospike nodes that responded to the info calls.
+	NodesResponded int32 `json:"nodesResponded"`
+
+	// ClusterSize is the cluster size as reported by the nodes.
+	// If nodes report different sizes, the smallest size is reported.
+	ClusterSize int32 `json:"clusterSize"`
+
+	// ClusterKeyAgreement is true if all the responding nodes report the same cluster_key and cluster_size.
+	ClusterKeyAgreement bool `json:"clusterKeyAgreement"`
+
+	// MigrateTxPartitionsRemaining is the number of partitions pending to be migrated out, across all nodes
+	// and namespaces.
+	MigrateTxPartitionsRemaining int64 `json:"migrateTxPartitionsRemaining"`
+
+	// MigrateRxPartitionsRemaining is the number of partitions pending to be migrated in, across all nodes
+	// and namespaces.
+	MigrateRxPartitionsRemaining int64 `json:"migrateRxPartitionsRemaining"`
+
+	// Namespaces has the health of each namespace.
+	// +optional
+	Namespaces []NamespaceHealthStatus `json:"namespaces,omitempty"`
+
+	// XDRDCs has the replication health of each XDR DC configured in aerospikeConfig.
+	// +optional
+	XDRDCs []XDRDCHealthStatus `json:"xdrDCs,omitempty"`
+}
+
+// XDRDCHealthStatus is the replication health of an XDR DC.
+type XDRDCHealthStatus struct {
+	// Name is the name of the DC.
+	Name string `json:"name"`
+
+	// Lag is the replication lag in seconds, i.e. the highest lag across nodes.
+	Lag int64 `json:"lag"`
+
+	// InQueue is the number of records waiting to be shipped to the DC, across all nodes.
+	InQueue int64 `json:"inQueue"`
+
+	// RecoveriesPending is the number of partitions waiting to be recovered, e.g. for a rewind, across all nodes.
+	RecoveriesPending int64 `json:"recoveriesPending"`
+}
+
+// NamespaceHealthStatus is the health of an Aerospike namespace.
+type NamespaceHealthStatus struct { //nolint:govet // for readability
+	// Name is the name of the namespace.
+	Name string `json:"name"`
+
+	// StrongConsistency is true if strong consistency is enabled for the namespace.
+	// +optional
+	StrongConsistency bool `json:"strongConsistency,omitempty"`
+
+	// UnavailablePartitions is the number of unavailable partitions of a strong consistency namespace.
+	// +optional
+	UnavailablePartitions int64 `json:"unavailablePartitions,omitempty"`
+
+	// DeadPartitions is the number of dead partitions of a strong consistency namespace.
+	// +optional
+	DeadPartitions int64 `json:"deadPartitions,omitempty"`
+
+	// MemoryUsedPct is the highest memory usage percentage of the namespace across nodes.
+	// +optional
+	MemoryUsedPct *int32 `json:"memoryUsedPct,omitempty"`
+
+	// DeviceUsedPct is the highest device usage percentage of the namespace across nodes.
+	// +optional
+	DeviceUsedPct *int32 `json:"deviceUsedPct,omitempty"`
+
+	// IndexUsedPct is the highest primary index usage percentage of the namespace across nodes. It is reported for
+	// the flash and pmem index types.
+	// +optional
+	IndexUsedPct *int32 `json:"indexUsedPct,omitempty"`
+
+	// DataUsedBytes is the total data used by the namespace across nodes.
+	// +optional
+	DataUsedBytes int64 `json:"dataUsedBytes,omitempty"`
+}
+
+// ReconcilePlan is the set of changes the operator would make to bring the cluster to the desired spec.
+type ReconcilePlan struct { //nolint:govet // for readability
+	// PlannedTime is the time when the plan was computed.
+	PlannedTime metav1.Time `json:"plannedTime"`
+
+	// Generation is the AerospikeCluster generation for which the plan was computed.
+	Generation int64 `json:"generation"`
+
+	// Error is the error faced while computing the plan, if any.
+	// The plan may be partial in case of an error.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// Racks has the planned changes of each rack. Racks without any change are not listed.
+	// +listType=map
+	// +listMapKey=id
+	// +optional
+	Racks []RackPlan `json:"racks,omitempty"`
+}
+
+type RackPlanAction string
+
+const (
+	// RackPlanActionCreate means the rack is created.
+	RackPlanActionCreate RackPlanAction = "Create"
+
+	// RackPlanActionUpdate means the existing rack is updated.
+	RackPlanActionUpdate RackPlanAction = "Update"
+
+	// RackPlanActionDelete means the rack is scaled down to zero and deleted.
+	RackPlanActionDelete RackPlanAction = "Delete"
+)
+
+// RackPlan is the set of planned changes of a rack.
+type RackPlan struct { //nolint:govet // for readability
+	// ID is the rack ID.
+	ID int `json:"id"`
+
+	// Action is the planned action on the rack.
+	// +kubebuilder:validation:Enum=Create;Update;Delete
+	Action RackPlanAction `json:"action"`
+
+	// CurrentSize is the current number of replicas of the rack StatefulSet.
+	CurrentSize int32 `json:"currentSize"`
+
+	// DesiredSize is the number of replicas of the rack StatefulSet after the changes.
+	DesiredSize int32 `json:"desiredSize"`
+
+	// ImageUpgrade is true if the rack pods are upgraded or downgraded to the desired images.
+	// +optional
+	ImageUpgrade bool `json:"imageUpgrade,omitempty"`
+
+	// StatefulSetChanges are the changes made directly to the rack StatefulSet.
+	// +optional
+	StatefulSetChanges []string `json:"statefulSetChanges,omitempty"`
+
+	// Pods has the planned changes of each rack pod. Pods without any change are not listed.
+	// +listType=map
+	// +listMapKey=podName
+	// +optional
+	Pods []PodPlan `json:"pods,omitempty"`
+}
+
+// PodPlan is the set of planned changes of a pod.
+type PodPlan struct { //nolint:govet // for readability
+	// PodName is the name of the pod.
+	PodName string `json:"podName"`
+
+	// RestartType is the way the pod is updated to the desired spec.
+	// +optional
+	RestartType PodRestartType `json:"restartType,omitempty"`
+
+	// Remove is true if the pod is removed by a scale-down or a rack deletion.
+	// +optional
+	Remove bool `json:"remove,omitempty"`
+
+	// DynamicConfigChanges are the aerospikeConfig changes applied dynamically to the pod.
+	// +optional
+	DynamicConfigChanges []DynamicConfigChange `json:"dynamicConfigChanges,omitempty"`
+}
+
+// DynamicConfigChange is an aerospikeConfig change applied dynamically to a pod.
+type DynamicConfigChange struct {
+	// Key is the flattened aerospikeConfig key.
+	Key string `json:"key"`
+
+	// Operation is the dynamic config operation, e.g. update, add or remove.
+	Operation string `json:"operation"`
+
+	// Value is the value set by the operation.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
 // AerospikeNetworkType specifies the type of network address to use.
 // +k8s:openapi-gen=true
 type AerospikeNetworkType string
@@ -26,6 +26,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	validate "github.com/asaskevich/govalidator"
 	"github.com/go-logr/logr"
@@ -66,6 +67,10 @@ func (acv *AerospikeClusterCustomValidator) ValidateCreate(_ context.Context, ob
 
 	aslog.Info("Validate create")
 
+	if aerospikeCluster.Spec.ScaleDownSelection != nil {
+		return nil, fmt.Errorf("spec.scaleDownSelection can be set only along with a scale-down")
+	}
+
 	return aerospikeCluster.validate(aslog)
 }
 
@@ -127,8 +132,17 @@ func (acv *AerospikeClusterCustomValidator) ValidateUpdate(_ context.Context, ol
 		return warnings, fmt.Errorf("failed to start upgrade: %v", err)
 	}
 
-	// Volume storage update is not allowed but cascadeDelete policy is allowed
-	if err := oldObject.Spec.Storage.validateStorageSpecChange(&aerospikeCluster.Spec.Storage); err != nil {
+	warns, err = aerospikeCluster.validateUpgradePath(oldObject)
+	warnings = append(warnings, warns...)
+
+	if err != nil {
+		return warnings, fmt.Errorf("failed to start upgrade: %v", err)
+	}
+
+	// Volume storage update is not allowed except pv size increase, storage class migration and cascadeDelete policy
+	if err := oldObject.Spec.Storage.validateStorageSpecChange(
+		&aerospikeCluster.Spec.Storage, aerospikeCluster.Spec.StorageClassMigration != nil,
+	); err != nil {
 		return warnings, fmt.Errorf("storage config cannot be updated: %v", err)
 	}
 
@@ -149,6 +163,26 @@ func (acv *AerospikeClusterCustomValidator) ValidateUpdate(_ context.Context, ol
 		return warnings, err
 	}
 
+	if err := validateSecondaryIndexesUpdate(&oldObject.Spec, &aerospikeCluster.Spec); err != nil {
+		return warnings, err
+	}
+
+	if err := aerospikeCluster.validateScaleDownSelectionUpdate(oldObject); err != nil {
+		return warnings, err
+	}
+
+	if err := aerospikeCluster.validateVolumeExpansion(oldObject); err != nil {
+		return warnings, err
+	}
+
+	if err := aerospikeCluster.validateStorageClassMigrationUpdate(oldObject); err != nil {
+		return warnings, err
+	}
+
+	if err := aerospikeCluster.validateVolumeSnapshotSourceUpdate(oldObject); err != nil {
+		return warnings, err
+	}
+
 	// Validate AerospikeConfig update
 	if err := validateAerospikeConfigUpdate(
 		aslog, aerospikeCluster.Spec.AerospikeConfig, oldObject.Spec.AerospikeConfig,
@@ -231,6 +265,38 @@ func (c *AerospikeCluster) validate(aslog logr.Logger) (admission.Warnings, erro
 		return warnings, err
 	}
 
+	if err := c.validateServerHealth(); err != nil {
+		return warnings, err
+	}
+
+	if err := c.validateSecondaryIndexes(); err != nil {
+		return warnings, err
+	}
+
+	if err := c.validateUDFModules(); err != nil {
+		return warnings, err
+	}
+
+	if err := c.validateUpgradeStrategy(); err != nil {
+		return warnings, err
+	}
+
+	if err := c.validateMaintenanceWindows(); err != nil {
+		return warnings, err
+	}
+
+	if err := c.validateScaleDownSelection(); err != nil {
+		return warnings, err
+	}
+
+	if err := c.validateAutoscaling(); err != nil {
+		return warnings, err
+	}
+
+	if err := c.validateVolumeSnapshotSource(); err != nil {
+		return warnings, err
+	}
+
 	// Storage should be validated before validating aerospikeConfig and fileStorage
 	if err := validateStorage(&c.Spec.Storage, &c.Spec.PodSpec); err != nil {
 		return warnings, err
@@ -313,6 +379,242 @@ func (c *AerospikeCluster) validateOperation() error {
 		return fmt.Errorf("operation cannot be added during aerospike cluster creation")
 	}
 
+	opIDs := sets.New[string]()
+
+	for idx := range c.Spec.Operations {
+		op := &c.Spec.Operations[idx]
+
+		if opIDs.Has(op.ID) {
+			return fmt.Errorf("duplicate operation ID %s", op.ID)
+		}
+
+		opIDs.Insert(op.ID)
+
+		if op.Kind != OperationTruncate && op.Truncate != nil {
+			return fmt.Errorf("truncate is allowed only for Truncate operation, operation %s", op.ID)
+		}
+
+		switch op.Kind {
+		case OperationRecluster:
+			if len(op.PodList) != 0 {
+				return fmt.Errorf("podList is not allowed for Recluster operation %s", op.ID)
+			}
+
+		case OperationTruncate:
+			if err := c.validateTruncateOperation(op); err != nil {
+				return err
+			}
+
+		case OperationQuiesce, OperationWarmRestart, OperationPodRestart:
+		}
+	}
+
+	return nil
+}
+
+func (c *AerospikeCluster) validateTruncateOperation(op *OperationSpec) error {
+	if len(op.PodList) != 0 {
+		return fmt.Errorf("podList is not allowed for Truncate operation %s", op.ID)
+	}
+
+	if op.Truncate == nil {
+		return fmt.Errorf("truncate is required for Truncate operation %s", op.ID)
+	}
+
+	if !c.isNamespacePresentInRacks(op.Truncate.Namespace) {
+		return fmt.Errorf(
+			"namespace %s of Truncate operation %s is not present in aerospikeConfig", op.Truncate.Namespace, op.ID,
+		)
+	}
+
+	if op.Truncate.LastUpdateTime != nil && op.Truncate.LastUpdateTime.After(time.Now()) {
+		return fmt.Errorf("lastUpdateTime of Truncate operation %s cannot be in the future", op.ID)
+	}
+
+	return nil
+}
+
+// isNamespacePresentInRacks returns true if the namespace is present in the aerospikeConfig of any rack.
+func (c *AerospikeCluster) isNamespacePresentInRacks(namespace string) bool {
+	for idx := range c.Spec.RackConfig.Racks {
+		if IsAerospikeNamespacePresent(c.Spec.RackConfig.Racks[idx].AerospikeConfig, namespace) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *AerospikeCluster) validateSecondaryIndexes() error {
+	indexNames := sets.New[string]()
+
+	for idx := range c.Spec.SecondaryIndexes {
+		sindex := &c.Spec.SecondaryIndexes[idx]
+
+		// Index names are unique per namespace.
+		nsIndexName := sindex.Namespace + "/" + sindex.Name
+		if indexNames.Has(nsIndexName) {
+			return fmt.Errorf("duplicate secondary index %s in namespace %s", sindex.Name, sindex.Namespace)
+		}
+
+		indexNames.Insert(nsIndexName)
+
+		if !c.isNamespacePresentInRacks(sindex.Namespace) {
+			return fmt.Errorf(
+				"namespace %s of secondary index %s is not present in aerospikeConfig", sindex.Namespace, sindex.Name,
+			)
+		}
+
+		for ctxIdx := range sindex.Context {
+			cdtCtx := &sindex.Context[ctxIdx]
+
+			switch cdtCtx.Type {
+			case CDTContextTypeListIndex, CDTContextTypeListRank, CDTContextTypeMapIndex, CDTContextTypeMapRank:
+				if cdtCtx.Value.Type != intstr.Int {
+					return fmt.Errorf(
+						"context value of type %s should be an integer in secondary index %s", cdtCtx.Type, sindex.Name,
+					)
+				}
+
+			case CDTContextTypeListValue, CDTContextTypeMapKey, CDTContextTypeMapValue:
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *AerospikeCluster) validateUDFModules() error {
+	configMapNames := sets.New[string]()
+	moduleNames := sets.New[string]()
+
+	for idx := range c.Spec.UDFModules {
+		source := &c.Spec.UDFModules[idx]
+
+		if configMapNames.Has(source.ConfigMapName) {
+			return fmt.Errorf("duplicate UDF modules ConfigMap %s", source.ConfigMapName)
+		}
+
+		configMapNames.Insert(source.ConfigMapName)
+
+		for _, module := range source.Modules {
+			if !strings.HasSuffix(module, ".lua") {
+				return fmt.Errorf("UDF module %s in ConfigMap %s should have .lua extension", module, source.ConfigMapName)
+			}
+
+			if moduleNames.Has(module) {
+				return fmt.Errorf("duplicate UDF module %s", module)
+			}
+
+			moduleNames.Insert(module)
+		}
+	}
+
+	return nil
+}
+
+// validateUpgradeStrategy validates that the canary pods are a subset of the cluster pods, and the rollback timeout.
+func (c *AerospikeCluster) validateUpgradeStrategy() error {
+	if c.Spec.UpgradeStrategy == nil {
+		return nil
+	}
+
+	rollback := c.Spec.UpgradeStrategy.Rollback
+	if rollback != nil && rollback.Timeout != nil && rollback.Timeout.Duration <= 0 {
+		return fmt.Errorf("spec.upgradeStrategy.rollback.timeout should be greater than zero")
+	}
+
+	canary := c.Spec.UpgradeStrategy.Canary
+	if canary == nil {
+		return nil
+	}
+
+	if canary.Pods != nil && canary.RackID != nil {
+		return fmt.Errorf("spec.upgradeStrategy.canary: pods and rackID cannot be given together")
+	}
+
+	if canary.Pods != nil {
+		if err := validateIntOrStringField(canary.Pods, "spec.upgradeStrategy.canary.pods"); err != nil {
+			return err
+		}
+
+		count, err := intstr.GetScaledValueFromIntOrPercent(canary.Pods, int(c.Spec.Size), true)
+		if err != nil {
+			return err
+		}
+
+		if count < 1 || count >= int(c.Spec.Size) {
+			return fmt.Errorf(
+				"spec.upgradeStrategy.canary.pods %s should be at least one pod and less than cluster size %d",
+				canary.Pods.String(), c.Spec.Size,
+			)
+		}
+	} else if c.Spec.Size < 2 {
+		return fmt.Errorf("spec.upgradeStrategy.canary cannot be used when cluster size is less than two")
+	}
+
+	if canary.RackID != nil {
+		if len(c.Spec.RackConfig.Racks) < 2 {
+			return fmt.Errorf("spec.upgradeStrategy.canary.rackID cannot be used when number of racks is less than two")
+		}
+
+		found := false
+
+		for idx := range c.Spec.RackConfig.Racks {
+			if c.Spec.RackConfig.Racks[idx].ID == *canary.RackID {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("spec.upgradeStrategy.canary.rackID %d is not present in rackConfig", *canary.RackID)
+		}
+	}
+
+	if canary.SoakDuration.Duration < 0 {
+		return fmt.Errorf("spec.upgradeStrategy.canary.soakDuration cannot be negative")
+	}
+
+	return nil
+}
+
+// validateSecondaryIndexesUpdate doesn't allow modifying an existing secondary index.
+// Index definition cannot be changed in the server, it has to be dropped and created with another name.
+func validateSecondaryIndexesUpdate(oldSpec, newSpec *AerospikeClusterSpec) error {
+	oldIndexes := make(map[string]*SecondaryIndexSpec, len(oldSpec.SecondaryIndexes))
+
+	for idx := range oldSpec.SecondaryIndexes {
+		oldIndex := &oldSpec.SecondaryIndexes[idx]
+		oldIndexes[oldIndex.Namespace+"/"+oldIndex.Name] = oldIndex
+	}
+
+	for idx := range newSpec.SecondaryIndexes {
+		newIndex := &newSpec.SecondaryIndexes[idx]
+
+		oldIndex, ok := oldIndexes[newIndex.Namespace+"/"+newIndex.Name]
+		if ok && !reflect.DeepEqual(oldIndex, newIndex) {
+			return fmt.Errorf(
+				"secondary index %s in namespace %s cannot be updated", newIndex.Name, newIndex.Namespace,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (c *AerospikeCluster) validateServerHealth() error {
+	if c.Spec.ServerHealth == nil {
+		return nil
+	}
+
+	if c.Spec.ServerHealth.RefreshPeriod.Duration < MinServerHealthRefreshPeriod {
+		return fmt.Errorf(
+			"serverHealth.refreshPeriod %s is less than minimum allowed %s",
+			c.Spec.ServerHealth.RefreshPeriod.Duration, MinServerHealthRefreshPeriod,
+		)
+	}
+
 	return nil
 }
 
@@ -502,7 +804,9 @@ func (c *AerospikeCluster) validateRackUpdate(
 					newStorage := newRack.Storage
 
 					// Volume storage update is not allowed but cascadeDelete policy is allowed
-					if err := oldStorage.validateStorageSpecChange(&newStorage); err != nil {
+					if err := oldStorage.validateStorageSpecChange(
+						&newStorage, c.Spec.StorageClassMigration != nil,
+					); err != nil {
 						return fmt.Errorf(
 							"rack storage config cannot be updated: %v", err,
 						)
@@ -663,10 +967,68 @@ func (c *AerospikeCluster) validateRackConfig(_ logr.Logger) error {
 			return err
 		}
 	}
+
+	if err := c.validateRollingUpdateStrategy(); err != nil {
+		return err
+	}
+
+	if err := c.validateRebalancePolicy(); err != nil {
+		return err
+	}
+
 	// TODO: should not use batch if racks are less than replication-factor
 	return nil
 }
 
+func (c *AerospikeCluster) validateRollingUpdateStrategy() error {
+	strategy := c.Spec.RackConfig.RollingUpdateStrategy
+	if strategy == nil {
+		return nil
+	}
+
+	rackIDs := sets.Set[int]{}
+	for idx := range c.Spec.RackConfig.Racks {
+		rackIDs.Insert(c.Spec.RackConfig.Racks[idx].ID)
+	}
+
+	orderedRackIDs := sets.Set[int]{}
+
+	for _, rackID := range strategy.RackOrder {
+		if !rackIDs.Has(rackID) {
+			return fmt.Errorf("spec.rackConfig.rollingUpdateStrategy.rackOrder has unknown rackID %d", rackID)
+		}
+
+		if orderedRackIDs.Has(rackID) {
+			return fmt.Errorf("spec.rackConfig.rollingUpdateStrategy.rackOrder has duplicate rackID %d", rackID)
+		}
+
+		orderedRackIDs.Insert(rackID)
+	}
+
+	if strategy.PauseAfterRack != nil && strategy.PauseAfterRack.Duration < 0 {
+		return fmt.Errorf(
+			"spec.rackConfig.rollingUpdateStrategy.pauseAfterRack %s cannot be negative", strategy.PauseAfterRack.Duration,
+		)
+	}
+
+	return nil
+}
+
+func (c *AerospikeCluster) validateRebalancePolicy() error {
+	policy := c.Spec.RackConfig.RebalancePolicy
+	if policy == nil || policy.MaxDataInMotion == nil {
+		return nil
+	}
+
+	if policy.MaxDataInMotion.Sign() <= 0 {
+		return fmt.Errorf(
+			"spec.rackConfig.rebalancePolicy.maxDataInMotion %s must be positive", policy.MaxDataInMotion.String(),
+		)
+	}
+
+	return nil
+}
+
 type nsConf struct {
 	noOfRacksForNamespaces int
 	replicationFactor      int
@@ -2332,27 +2694,37 @@ func (c *AerospikeCluster) validateEnableDynamicConfigUpdate() error {
 }
 
 func validateOperationUpdate(oldSpec, newSpec *AerospikeClusterSpec, status *AerospikeClusterStatus) error {
-	if len(newSpec.Operations) == 0 {
-		return nil
+	if err := validateOperationQueueUpdate(
+		oldSpec.Operations, newSpec.Operations, status.OperationHistory,
+	); err != nil {
+		return err
 	}
 
-	newOp := &newSpec.Operations[0]
-
-	var oldOp *OperationSpec
-
-	if len(oldSpec.Operations) != 0 {
-		oldOp = &oldSpec.Operations[0]
+	if len(newSpec.Operations) == 0 {
+		return nil
 	}
 
-	if oldOp != nil && oldOp.ID == newOp.ID && !reflect.DeepEqual(oldOp, newOp) {
-		return fmt.Errorf("operation %s cannot be updated", newOp.ID)
+	oldOps := make(map[string]*OperationSpec, len(oldSpec.Operations))
+	for idx := range oldSpec.Operations {
+		oldOps[oldSpec.Operations[idx].ID] = &oldSpec.Operations[idx]
 	}
 
 	allPodNames := GetAllPodNames(status.Pods)
 
-	podSet := sets.New(newSpec.Operations[0].PodList...)
-	if !allPodNames.IsSuperset(podSet) {
-		return fmt.Errorf("invalid pod names in operation %v", podSet.Difference(allPodNames).UnsortedList())
+	// Queued operations can be added or removed, but not updated.
+	for idx := range newSpec.Operations {
+		newOp := &newSpec.Operations[idx]
+
+		if oldOp, ok := oldOps[newOp.ID]; ok && !reflect.DeepEqual(oldOp, newOp) {
+			return fmt.Errorf("operation %s cannot be updated", newOp.ID)
+		}
+
+		podSet := sets.New(newOp.PodList...)
+		if !allPodNames.IsSuperset(podSet) {
+			return fmt.Errorf(
+				"invalid pod names in operation %s %v", newOp.ID, podSet.Difference(allPodNames).UnsortedList(),
+			)
+		}
 	}
 
 	// Don't allow any on-demand operation along with these cluster change:
@@ -2374,3 +2746,66 @@ func validateOperationUpdate(oldSpec, newSpec *AerospikeClusterSpec, status *Aer
 
 	return nil
 }
+
+// validateOperationQueueUpdate validates the changes of the operation queue against the operations which have
+// started, as recorded in status.operationHistory. The existing operations cannot be reordered, new operations can
+// only be added after the started operations, and the running operation cannot be removed unless it failed.
+// Pending and finished operations can be removed.
+func validateOperationQueueUpdate(oldOps, newOps []OperationSpec, history []OperationStatus) error {
+	newOpIndexes := make(map[string]int, len(newOps))
+	for idx := range newOps {
+		newOpIndexes[newOps[idx].ID] = idx
+	}
+
+	oldOpIDs := sets.New[string]()
+	lastIdx, lastStartedIdx := -1, -1
+
+	for idx := range oldOps {
+		opID := oldOps[idx].ID
+		oldOpIDs.Insert(opID)
+
+		phase := getLatestOperationPhase(history, opID)
+
+		newIdx, ok := newOpIndexes[opID]
+		if !ok {
+			if phase == OperationRunning {
+				return fmt.Errorf("running operation %s cannot be removed", opID)
+			}
+
+			continue
+		}
+
+		if newIdx < lastIdx {
+			return fmt.Errorf("operation %s cannot be moved before operation %s", opID, newOps[lastIdx].ID)
+		}
+
+		lastIdx = newIdx
+
+		if phase != "" && phase != OperationPending {
+			lastStartedIdx = newIdx
+		}
+	}
+
+	for idx := 0; idx < lastStartedIdx; idx++ {
+		if !oldOpIDs.Has(newOps[idx].ID) {
+			return fmt.Errorf(
+				"operation %s can only be added after the started operation %s", newOps[idx].ID,
+				newOps[lastStartedIdx].ID,
+			)
+		}
+	}
+
+	return nil
+}
+
+// getLatestOperationPhase returns the phase of the latest entry of the operation in the operation history.
+// Returns an empty phase if the operation is not in the history.
+func getLatestOperationPhase(history []OperationStatus, opID string) OperationPhase {
+	for idx := len(history) - 1; idx >= 0; idx-- {
+		if history[idx].ID == opID {
+			return history[idx].Phase
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"testing"
+)
+
+func TestValidateOperationQueueUpdate(t *testing.T) {
+	newOps := func(ids ...string) []OperationSpec {
+		ops := make([]OperationSpec, 0, len(ids))
+		for _, id := range ids {
+			ops = append(ops, OperationSpec{ID: id, Kind: OperationWarmRestart})
+		}
+
+		return ops
+	}
+
+	history := []OperationStatus{
+		{ID: "done", Phase: OperationSucceeded},
+		{ID: "running", Phase: OperationRunning},
+		{ID: "pending-1", Phase: OperationPending},
+		{ID: "pending-2", Phase: OperationPending},
+	}
+	oldOps := newOps("done", "running", "pending-1", "pending-2")
+
+	tests := []struct {
+		name    string
+		oldOps  []OperationSpec
+		newOps  []OperationSpec
+		history []OperationStatus
+		wantErr bool
+	}{
+		{
+			name:   "unchanged",
+			oldOps: oldOps,
+			newOps: oldOps,
+		},
+		{
+			name:   "operation appended",
+			oldOps: oldOps,
+			newOps: newOps("done", "running", "pending-1", "pending-2", "new"),
+		},
+		{
+			name:   "operation added between pending operations",
+			oldOps: oldOps,
+			newOps: newOps("done", "running", "pending-1", "new", "pending-2"),
+		},
+		{
+			name:   "pending operation removed",
+			oldOps: oldOps,
+			newOps: newOps("done", "running", "pending-2"),
+		},
+		{
+			name:   "finished operation removed",
+			oldOps: oldOps,
+			newOps: newOps("running", "pending-1", "pending-2"),
+		},
+		{
+			name:    "operation added before the running operation",
+			oldOps:  oldOps,
+			newOps:  newOps("done", "new", "running", "pending-1", "pending-2"),
+			wantErr: true,
+		},
+		{
+			name:    "operation added at the head",
+			oldOps:  oldOps,
+			newOps:  newOps("new", "done", "running", "pending-1", "pending-2"),
+			wantErr: true,
+		},
+		{
+			name:    "running operation removed",
+			oldOps:  oldOps,
+			newOps:  newOps("done", "pending-1", "pending-2"),
+			wantErr: true,
+		},
+		{
+			name:    "pending operation moved before the running operation",
+			oldOps:  oldOps,
+			newOps:  newOps("done", "pending-1", "running", "pending-2"),
+			wantErr: true,
+		},
+		{
+			name:    "pending operations reordered",
+			oldOps:  oldOps,
+			newOps:  newOps("done", "running", "pending-2", "pending-1"),
+			wantErr: true,
+		},
+		{
+			name:   "failed operation removed",
+			oldOps: newOps("failed", "pending-1"),
+			newOps: newOps("pending-1"),
+			history: []OperationStatus{
+				{ID: "failed", Phase: OperationFailed},
+				{ID: "pending-1", Phase: OperationPending},
+			},
+		},
+		{
+			name:   "operation resubmitted after a succeeded run",
+			oldOps: newOps("running"),
+			newOps: newOps("running", "new"),
+			history: []OperationStatus{
+				{ID: "running", Phase: OperationSucceeded},
+				{ID: "running", Phase: OperationRunning},
+			},
+		},
+		{
+			name:   "operations added before any operation is recorded",
+			oldOps: newOps("pending-1"),
+			newOps: newOps("new", "pending-1"),
+		},
+	}
+
+	for _, test := range tests {
+		testHistory := test.history
+		if testHistory == nil {
+			testHistory = history
+		}
+
+		err := validateOperationQueueUpdate(test.oldOps, test.newOps, testHistory)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.wantErr, err)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (a *AutoscalingSpec) setDefaults() {
+	if len(a.Metrics) == 0 {
+		a.Metrics = []AutoscalingMetric{AutoscalingMetricMemory, AutoscalingMetricDevice, AutoscalingMetricIndex}
+	}
+
+	if a.HighWatermarkPct == 0 {
+		a.HighWatermarkPct = DefaultAutoscalingHighWatermarkPct
+	}
+
+	if a.LowWatermarkPct == 0 {
+		a.LowWatermarkPct = DefaultAutoscalingLowWatermarkPct
+	}
+
+	if a.ScaleUpCooldown == nil {
+		a.ScaleUpCooldown = &metav1.Duration{Duration: DefaultAutoscalingScaleUpCooldown}
+	}
+
+	if a.ScaleDownCooldown == nil {
+		a.ScaleDownCooldown = &metav1.Duration{Duration: DefaultAutoscalingScaleDownCooldown}
+	}
+}
+
+func (c *AerospikeCluster) validateAutoscaling() error {
+	autoscaling := c.Spec.Autoscaling
+	if autoscaling == nil {
+		return nil
+	}
+
+	if c.Spec.ServerHealth == nil {
+		return fmt.Errorf("spec.autoscaling requires spec.serverHealth to be set")
+	}
+
+	if autoscaling.MinSize > autoscaling.MaxSize {
+		return fmt.Errorf(
+			"spec.autoscaling.minSize %d cannot be more than maxSize %d", autoscaling.MinSize, autoscaling.MaxSize,
+		)
+	}
+
+	if autoscaling.MaxSize > maxEnterpriseClusterSize {
+		return fmt.Errorf("spec.autoscaling.maxSize cannot be more than %d", maxEnterpriseClusterSize)
+	}
+
+	if c.Spec.Size < autoscaling.MinSize || c.Spec.Size > autoscaling.MaxSize {
+		return fmt.Errorf(
+			"spec.size %d must be between spec.autoscaling.minSize %d and maxSize %d", c.Spec.Size,
+			autoscaling.MinSize, autoscaling.MaxSize,
+		)
+	}
+
+	if autoscaling.LowWatermarkPct >= autoscaling.HighWatermarkPct {
+		return fmt.Errorf(
+			"spec.autoscaling.lowWatermarkPct %d must be less than highWatermarkPct %d",
+			autoscaling.LowWatermarkPct, autoscaling.HighWatermarkPct,
+		)
+	}
+
+	if autoscaling.ScaleUpCooldown.Duration < 0 || autoscaling.ScaleDownCooldown.Duration < 0 {
+		return fmt.Errorf("spec.autoscaling cooldowns cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// parseSchedule parses the cron schedule and the time zone of the maintenance window.
+func (w *MaintenanceWindow) parseSchedule() (cron.Schedule, *time.Location, error) {
+	schedule, err := cron.ParseStandard(w.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule %s: %v", w.Schedule, err)
+	}
+
+	loc := time.UTC
+
+	if w.TimeZone != "" {
+		if loc, err = time.LoadLocation(w.TimeZone); err != nil {
+			return nil, nil, fmt.Errorf("invalid time zone %s: %v", w.TimeZone, err)
+		}
+	}
+
+	return schedule, loc, nil
+}
+
+// IsOpen returns true if the maintenance window is open at the given time.
+func (w *MaintenanceWindow) IsOpen(now time.Time) (bool, error) {
+	schedule, loc, err := w.parseSchedule()
+	if err != nil {
+		return false, err
+	}
+
+	// The window is open if it started in the last window duration.
+	start := schedule.Next(now.Add(-w.Duration.Duration).In(loc))
+
+	return !start.IsZero() && !start.After(now), nil
+}
+
+// NextStart returns the start time of the next maintenance window after the given time.
+// It returns the zero time if the schedule has no activation.
+func (w *MaintenanceWindow) NextStart(now time.Time) (time.Time, error) {
+	schedule, loc, err := w.parseSchedule()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return schedule.Next(now.In(loc)), nil
+}
+
+func (c *AerospikeCluster) validateMaintenanceWindows() error {
+	for idx := range c.Spec.MaintenanceWindows {
+		window := &c.Spec.MaintenanceWindows[idx]
+
+		if window.Duration.Duration <= 0 {
+			return fmt.Errorf("spec.maintenanceWindows[%d].duration must be positive", idx)
+		}
+
+		nextStart, err := window.NextStart(time.Now())
+		if err != nil {
+			return fmt.Errorf("invalid spec.maintenanceWindows[%d]: %v", idx, err)
+		}
+
+		if nextStart.IsZero() {
+			return fmt.Errorf("spec.maintenanceWindows[%d].schedule %s never starts", idx, window.Schedule)
+		}
+	}
+
+	return nil
+}
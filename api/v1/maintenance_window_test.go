@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMaintenanceWindowNextStart(t *testing.T) {
+	from := time.Date(2024, time.March, 15, 10, 30, 45, 0, time.UTC) // Friday
+
+	tests := []struct {
+		name     string
+		schedule string
+		timeZone string
+		want     time.Time
+	}{
+		{
+			name:     "daily",
+			schedule: "0 2 * * *",
+			want:     time.Date(2024, time.March, 16, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "week days",
+			schedule: "0 22 * * 1-5",
+			want:     time.Date(2024, time.March, 15, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "day of month or day of week",
+			schedule: "0 0 1 * 3",
+			want:     time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "macro",
+			schedule: "@monthly",
+			want:     time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "time zone",
+			schedule: "0 2 * * *",
+			timeZone: "Asia/Kolkata",
+			want:     time.Date(2024, time.March, 15, 20, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "never starts",
+			schedule: "0 0 30 2 *",
+		},
+	}
+
+	for _, test := range tests {
+		window := &MaintenanceWindow{Schedule: test.schedule, TimeZone: test.timeZone}
+
+		got, err := window.NextStart(from)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		if !got.Equal(test.want) {
+			t.Errorf("%s: expected next start %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestMaintenanceWindowIsOpen(t *testing.T) {
+	window := &MaintenanceWindow{
+		Schedule: "0 2 * * 6", // Saturday 02:00
+		Duration: metav1.Duration{Duration: 2 * time.Hour},
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{
+			name: "before the window",
+			now:  time.Date(2024, time.March, 16, 1, 59, 0, 0, time.UTC),
+		},
+		{
+			name: "window start",
+			now:  time.Date(2024, time.March, 16, 2, 0, 0, 0, time.UTC),
+			want: true,
+		},
+		{
+			name: "in the window",
+			now:  time.Date(2024, time.March, 16, 3, 30, 0, 0, time.UTC),
+			want: true,
+		},
+		{
+			name: "after the window",
+			now:  time.Date(2024, time.March, 16, 4, 1, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		got, err := window.IsOpen(test.now)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		if got != test.want {
+			t.Errorf("%s: expected open %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestMaintenanceWindowInvalid(t *testing.T) {
+	for _, window := range []MaintenanceWindow{
+		{Schedule: "* * * *"},
+		{Schedule: "60 * * * *"},
+		{Schedule: "* * * 13 *"},
+		{Schedule: "0 2 * * *", TimeZone: "Invalid/Zone"},
+	} {
+		if _, err := window.NextStart(time.Now()); err == nil {
+			t.Errorf("expected error for schedule %q in time zone %q", window.Schedule, window.TimeZone)
+		}
+	}
+}
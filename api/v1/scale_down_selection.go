@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func (c *AerospikeCluster) validateScaleDownSelection() error {
+	selection := c.Spec.ScaleDownSelection
+	if selection == nil {
+		return nil
+	}
+
+	// Pod names are <cluster-name>-<rack-id>-<ordinal>.
+	podNameRegex := regexp.MustCompile("^" + regexp.QuoteMeta(c.Name) + `-\d+-\d+$`)
+	podNames := sets.NewString()
+
+	for _, podName := range selection.PodNames {
+		if !podNameRegex.MatchString(podName) {
+			return fmt.Errorf("spec.scaleDownSelection.podNames: %s is not a pod of cluster %s", podName, c.Name)
+		}
+
+		if podNames.Has(podName) {
+			return fmt.Errorf("spec.scaleDownSelection.podNames: duplicate pod %s", podName)
+		}
+
+		podNames.Insert(podName)
+	}
+
+	nodeNames := sets.NewString()
+
+	for _, nodeName := range selection.K8sNodeNames {
+		if nodeName == "" {
+			return fmt.Errorf("spec.scaleDownSelection.k8sNodeNames cannot have an empty node name")
+		}
+
+		if nodeNames.Has(nodeName) {
+			return fmt.Errorf("spec.scaleDownSelection.k8sNodeNames: duplicate node %s", nodeName)
+		}
+
+		nodeNames.Insert(nodeName)
+	}
+
+	return nil
+}
+
+// validateScaleDownSelectionUpdate validates that pods are selected for removal only along with a scale-down, and
+// not more pods than the ones removed by the scale-down.
+func (c *AerospikeCluster) validateScaleDownSelectionUpdate(oldObj *AerospikeCluster) error {
+	var oldPodNames, oldNodeNames []string
+
+	if oldObj.Spec.ScaleDownSelection != nil {
+		oldPodNames = oldObj.Spec.ScaleDownSelection.PodNames
+		oldNodeNames = oldObj.Spec.ScaleDownSelection.K8sNodeNames
+	}
+
+	var newPodNames, newNodeNames []string
+
+	if c.Spec.ScaleDownSelection != nil {
+		newPodNames = c.Spec.ScaleDownSelection.PodNames
+		newNodeNames = c.Spec.ScaleDownSelection.K8sNodeNames
+	}
+
+	if sets.NewString(oldPodNames...).IsSuperset(sets.NewString(newPodNames...)) &&
+		sets.NewString(oldNodeNames...).IsSuperset(sets.NewString(newNodeNames...)) {
+		// Removing selected pods or nodes is always allowed.
+		return nil
+	}
+
+	// The scale-down may be in progress from a previous update.
+	currentSize := max(oldObj.Spec.Size, c.Status.Size)
+	if c.Spec.Size >= currentSize {
+		return fmt.Errorf(
+			"spec.scaleDownSelection can be set only along with a scale-down, current size %d, new size %d",
+			currentSize, c.Spec.Size,
+		)
+	}
+
+	if removedPods := int(currentSize - c.Spec.Size); len(newPodNames) > removedPods {
+		return fmt.Errorf(
+			"spec.scaleDownSelection.podNames has %d pods, more than the %d pods removed by the scale-down",
+			len(newPodNames), removedPods,
+		)
+	}
+
+	return nil
+}
@@ -1,24 +1,31 @@
 package v1
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"reflect"
 
 	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // validateStorageSpecChange indicates if a change to storage spec is safe to apply.
-func (s *AerospikeStorageSpec) validateStorageSpecChange(newStorage *AerospikeStorageSpec) error {
+// The storage class of a pv can be changed only if allowStorageClassChange is true.
+func (s *AerospikeStorageSpec) validateStorageSpecChange(
+	newStorage *AerospikeStorageSpec, allowStorageClassChange bool,
+) error {
 	for newVolIdx := range newStorage.Volumes {
 		newVolume := &newStorage.Volumes[newVolIdx]
 
 		for oldVolIdx := range s.Volumes {
 			oldVolume := &s.Volumes[oldVolIdx]
 			if oldVolume.Name == newVolume.Name {
-				if !oldVolume.isSafeChange(newVolume) {
+				if !oldVolume.isSafeChange(newVolume, allowStorageClassChange) {
 					// Validate same volumes
 					return fmt.Errorf(
 						"cannot change volumes old: %v newStorage %v", oldVolume,
@@ -113,6 +120,10 @@ func (s *AerospikeStorageSpec) SetDefaults() {
 		s.CleanupThreads = defaultCleanupThreads
 	}
 
+	if s.LocalPVRecovery != nil && s.LocalPVRecovery.GracePeriod == nil {
+		s.LocalPVRecovery.GracePeriod = &metav1.Duration{Duration: DefaultLocalPVRecoveryGracePeriod}
+	}
+
 	for idx := range s.Volumes {
 		switch {
 		case s.Volumes[idx].Source.PersistentVolume == nil:
@@ -315,6 +326,23 @@ func validateStorage(
 		}
 	}
 
+	return validateLocalPVRecovery(storage)
+}
+
+func validateLocalPVRecovery(storage *AerospikeStorageSpec) error {
+	recovery := storage.LocalPVRecovery
+	if recovery == nil || !recovery.Enabled {
+		return nil
+	}
+
+	if len(storage.LocalStorageClasses) == 0 {
+		return fmt.Errorf("localPVRecovery requires localStorageClasses to be set")
+	}
+
+	if recovery.GracePeriod != nil && recovery.GracePeriod.Duration < 0 {
+		return fmt.Errorf("localPVRecovery gracePeriod cannot be negative")
+	}
+
 	return nil
 }
 
@@ -399,8 +427,9 @@ func validateAttachment(
 }
 
 // isSafeChange indicates if a change to a volume is safe to allow.
-func (v *VolumeSpec) isSafeChange(newVolume *VolumeSpec) bool {
-	// Allow all type of sources, except pv
+func (v *VolumeSpec) isSafeChange(newVolume *VolumeSpec, allowStorageClassChange bool) bool {
+	// Allow all type of sources, except pv. The non-pv volumes, including the pvcs of ephemeral volumes, are
+	// recreated with the pods by a rolling restart.
 	if v.Source.PersistentVolume == nil && newVolume.Source.PersistentVolume == nil {
 		return true
 	}
@@ -410,12 +439,163 @@ func (v *VolumeSpec) isSafeChange(newVolume *VolumeSpec) bool {
 		return false
 	}
 
-	oldPV := v.Source.PersistentVolume
+	// Increasing the size of a pv is allowed, it is validated against the storage class by
+	// validateVolumeExpansion.
+	oldPV := v.Source.PersistentVolume.DeepCopy()
 	newPV := newVolume.Source.PersistentVolume
 
+	if newPV.Size.Cmp(oldPV.Size) > 0 {
+		oldPV.Size = newPV.Size
+	}
+
+	// The pvcs of a pv with a new storage class are replaced by the storage class migration.
+	if allowStorageClassChange && newPV.StorageClass != oldPV.StorageClass {
+		oldPV.StorageClass = newPV.StorageClass
+		oldPV.Size = newPV.Size
+	}
+
 	return reflect.DeepEqual(oldPV, newPV)
 }
 
+// getExpandedStorageClasses returns the storage classes of the persistent volumes whose size is increased.
+// The volumes whose storage class is changed are not expanded, their pvcs are replaced.
+func (s *AerospikeStorageSpec) getExpandedStorageClasses(newStorage *AerospikeStorageSpec) sets.Set[string] {
+	storageClasses := sets.New[string]()
+
+	for newVolIdx := range newStorage.Volumes {
+		newPV := newStorage.Volumes[newVolIdx].Source.PersistentVolume
+		if newPV == nil {
+			continue
+		}
+
+		for oldVolIdx := range s.Volumes {
+			oldVolume := &s.Volumes[oldVolIdx]
+			if oldVolume.Name == newStorage.Volumes[newVolIdx].Name {
+				oldPV := oldVolume.Source.PersistentVolume
+				if oldPV != nil && oldPV.StorageClass == newPV.StorageClass && newPV.Size.Cmp(oldPV.Size) > 0 {
+					storageClasses.Insert(newPV.StorageClass)
+				}
+
+				break
+			}
+		}
+	}
+
+	return storageClasses
+}
+
+// hasStorageClassChange returns true if the storage class of any persistent volume is changed.
+func (s *AerospikeStorageSpec) hasStorageClassChange(newStorage *AerospikeStorageSpec) bool {
+	for newVolIdx := range newStorage.Volumes {
+		newPV := newStorage.Volumes[newVolIdx].Source.PersistentVolume
+		if newPV == nil {
+			continue
+		}
+
+		for oldVolIdx := range s.Volumes {
+			oldVolume := &s.Volumes[oldVolIdx]
+			if oldVolume.Name == newStorage.Volumes[newVolIdx].Name {
+				oldPV := oldVolume.Source.PersistentVolume
+				if oldPV != nil && oldPV.StorageClass != newPV.StorageClass {
+					return true
+				}
+
+				break
+			}
+		}
+	}
+
+	return false
+}
+
+// validateStorageClassMigrationUpdate validates that the storage class of persistent volumes is changed only if
+// Aerospike can repopulate the replaced volumes from another replica, and that spec.storageClassMigration is not
+// removed before the migration is completed.
+func (c *AerospikeCluster) validateStorageClassMigrationUpdate(oldObj *AerospikeCluster) error {
+	if oldObj.Spec.StorageClassMigration != nil && c.Spec.StorageClassMigration == nil {
+		migrationStatus := oldObj.Status.StorageClassMigration
+		if migrationStatus == nil || migrationStatus.Phase != StorageClassMigrationCompleted {
+			return fmt.Errorf("spec.storageClassMigration cannot be removed before the storage class migration is " +
+				"completed")
+		}
+	}
+
+	storageClassChanged := oldObj.Spec.Storage.hasStorageClassChange(&c.Spec.Storage)
+
+	for newRackIdx := range c.Spec.RackConfig.Racks {
+		newRack := &c.Spec.RackConfig.Racks[newRackIdx]
+
+		for oldRackIdx := range oldObj.Spec.RackConfig.Racks {
+			oldRack := &oldObj.Spec.RackConfig.Racks[oldRackIdx]
+			if oldRack.ID == newRack.ID {
+				storageClassChanged = storageClassChanged || oldRack.Storage.hasStorageClassChange(&newRack.Storage)
+				break
+			}
+		}
+	}
+
+	if !storageClassChanged {
+		return nil
+	}
+
+	// The data of a pod whose volumes are replaced is only available in the other replicas.
+	for ns, nsConf := range getNsConfForNamespaces(c.Spec.RackConfig) {
+		if nsConf.replicationFactor < 2 {
+			return fmt.Errorf(
+				"storage class cannot be changed as replication-factor %d of namespace %s is less than 2",
+				nsConf.replicationFactor, ns,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateVolumeExpansion validates that the storage classes of the persistent volumes whose size is increased allow
+// volume expansion.
+func (c *AerospikeCluster) validateVolumeExpansion(oldObj *AerospikeCluster) error {
+	storageClasses := oldObj.Spec.Storage.getExpandedStorageClasses(&c.Spec.Storage)
+
+	for newRackIdx := range c.Spec.RackConfig.Racks {
+		newRack := &c.Spec.RackConfig.Racks[newRackIdx]
+
+		for oldRackIdx := range oldObj.Spec.RackConfig.Racks {
+			oldRack := &oldObj.Spec.RackConfig.Racks[oldRackIdx]
+			if oldRack.ID == newRack.ID {
+				storageClasses = storageClasses.Union(oldRack.Storage.getExpandedStorageClasses(&newRack.Storage))
+				break
+			}
+		}
+	}
+
+	if storageClasses.Len() == 0 {
+		return nil
+	}
+
+	k8sClient, err := NewK8sClient()
+	if err != nil {
+		return err
+	}
+
+	for _, storageClassName := range sets.List(storageClasses) {
+		storageClass := &storagev1.StorageClass{}
+		if err := k8sClient.Get(
+			context.TODO(), types.NamespacedName{Name: storageClassName}, storageClass,
+		); err != nil {
+			return fmt.Errorf("failed to get storage class %s for volume expansion: %v", storageClassName, err)
+		}
+
+		if !GetBool(storageClass.AllowVolumeExpansion) {
+			return fmt.Errorf(
+				"cannot increase the size of volumes, storage class %s does not allow volume expansion",
+				storageClassName,
+			)
+		}
+	}
+
+	return nil
+}
+
 func validateStorageVolumeSource(volume *VolumeSpec) error {
 	source := volume.Source
 	sourceCount := 0
@@ -432,6 +612,22 @@ func validateStorageVolumeSource(volume *VolumeSpec) error {
 		sourceCount++
 	}
 
+	if source.Projected != nil {
+		sourceCount++
+	}
+
+	if source.CSI != nil {
+		sourceCount++
+	}
+
+	if source.Ephemeral != nil {
+		sourceCount++
+	}
+
+	if source.HostPath != nil {
+		sourceCount++
+	}
+
 	if source.PersistentVolume != nil {
 		sourceCount++
 	}
@@ -444,6 +640,22 @@ func validateStorageVolumeSource(volume *VolumeSpec) error {
 		return fmt.Errorf("can not specify more than 1 source")
 	}
 
+	if source.Projected != nil && len(source.Projected.Sources) == 0 {
+		return fmt.Errorf("projected volume %s has no sources", volume.Name)
+	}
+
+	if source.CSI != nil && source.CSI.Driver == "" {
+		return fmt.Errorf("csi volume %s has no driver", volume.Name)
+	}
+
+	if source.Ephemeral != nil && source.Ephemeral.VolumeClaimTemplate == nil {
+		return fmt.Errorf("ephemeral volume %s has no volumeClaimTemplate", volume.Name)
+	}
+
+	if source.HostPath != nil && !filepath.IsAbs(source.HostPath.Path) {
+		return fmt.Errorf("hostPath volume %s path `%s` should be absolute", volume.Name, source.HostPath.Path)
+	}
+
 	if source.PersistentVolume != nil {
 		// Validate VolumeMode
 		vm := source.PersistentVolume.VolumeMode
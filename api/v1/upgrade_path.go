@@ -0,0 +1,234 @@
+package v1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	lib "github.com/aerospike/aerospike-management-lib"
+)
+
+// versionTransition is the direction of a server version change.
+type versionTransition string
+
+const (
+	versionTransitionUpgrade   versionTransition = "upgrade"
+	versionTransitionDowngrade versionTransition = "downgrade"
+)
+
+// maxMajorVersionJump is the maximum number of major versions a single upgrade can move forward.
+const maxMajorVersionJump = 1
+
+// AllowUnsafeDowngradeAnnotation is the AerospikeCluster annotation used to allow a server downgrade which is
+// otherwise rejected by the upgrade path rules. Its value should be the image being downgraded to.
+const AllowUnsafeDowngradeAnnotation = "asdb.aerospike.com/allow-unsafe-downgrade"
+
+// upgradePathRule is a risky server version change which is allowed with an admission warning, or rejected unless
+// the AllowUnsafeDowngradeAnnotation is set.
+// A change from A to B crosses release R if A < R <= B for an upgrade, or B < R <= A for a downgrade.
+// Only major and minor versions are compared.
+type upgradePathRule struct {
+	// release is the server release which the rule applies to.
+	release string
+	// transition is the direction of the version change which the rule applies to.
+	transition versionTransition
+	// message describes why the transition is risky.
+	message string
+	// reject is true if the transition is rejected unless the AllowUnsafeDowngradeAnnotation is set.
+	reject bool
+	// strongConsistencyOnly limits the rejection to clusters with a strong-consistency namespace. The other clusters
+	// only get a warning.
+	strongConsistencyOnly bool
+}
+
+// upgradePathRules is the table of version transitions which need special attention.
+var upgradePathRules = []upgradePathRule{
+	{
+		release:    "7.0",
+		transition: versionTransitionUpgrade,
+		message: "7.0 replaces the namespace memory-size and data-in-memory configuration with the memory " +
+			"storage-engine, aerospikeConfig must use the 7.0 namespace storage configuration",
+	},
+	{
+		release:    "7.0",
+		transition: versionTransitionDowngrade,
+		message: "aerospikeConfig must use the pre-7.0 namespace memory configuration " +
+			"and the data of in-memory namespaces is lost",
+		reject: true,
+	},
+	{
+		release:    "8.0",
+		transition: versionTransitionDowngrade,
+		message: "a downgrade below 8.0 is not supported if multi-record transactions have been used " +
+			"on a strong-consistency namespace",
+		reject:                true,
+		strongConsistencyOnly: true,
+	},
+}
+
+// validateUpgradePath validates the server version change from the running images of the cluster to the new image.
+// Upgrades skipping a major version and downgrades crossing a rejecting rule are rejected, and warnings are returned
+// for the other upgrade path rules crossed. The AllowUnsafeDowngradeAnnotation set to the new image turns the
+// rejected downgrades into warnings.
+func (c *AerospikeCluster) validateUpgradePath(oldObj *AerospikeCluster) (admission.Warnings, error) {
+	// The running pods can be on the status image or, if an upgrade is in progress, on the old spec image.
+	fromImages := []string{oldObj.Spec.Image}
+
+	if c.Status.Image != "" {
+		// Going back to the image the cluster was running, e.g. when rolling back a failed upgrade,
+		// is always allowed.
+		if c.Status.Image == c.Spec.Image {
+			return nil, nil
+		}
+
+		if c.Status.Image != oldObj.Spec.Image {
+			fromImages = append(fromImages, c.Status.Image)
+		}
+	}
+
+	toVersion, err := GetImageVersion(c.Spec.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	strongConsistency := c.hasStrongConsistencyNamespace()
+	allowUnsafeDowngrade := c.Annotations[AllowUnsafeDowngradeAnnotation] == c.Spec.Image
+
+	var warnings admission.Warnings
+
+	for _, fromImage := range fromImages {
+		fromVersion, vErr := GetImageVersion(fromImage)
+		if vErr != nil {
+			return warnings, vErr
+		}
+
+		warns, pErr := validateVersionTransition(fromVersion, toVersion, strongConsistency, allowUnsafeDowngrade)
+		warnings = append(warnings, warns...)
+
+		if pErr != nil {
+			return warnings, pErr
+		}
+	}
+
+	return warnings, nil
+}
+
+// validateVersionTransition validates the server version change from fromVersion to toVersion.
+// strongConsistency is true if the cluster has a strong-consistency namespace. If allowUnsafeDowngrade is true, the
+// rejecting rules crossed only return warnings.
+func validateVersionTransition(
+	fromVersion, toVersion string, strongConsistency, allowUnsafeDowngrade bool,
+) (admission.Warnings, error) {
+	val, err := lib.CompareVersionsIgnoreRevision(fromVersion, toVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare versions %s and %s: %v", fromVersion, toVersion, err)
+	}
+
+	if val == 0 {
+		return nil, nil
+	}
+
+	transition := versionTransitionUpgrade
+	lowVersion, highVersion := fromVersion, toVersion
+
+	if val > 0 {
+		transition = versionTransitionDowngrade
+		lowVersion, highVersion = toVersion, fromVersion
+	}
+
+	if transition == versionTransitionUpgrade {
+		if mErr := validateMajorVersionJump(fromVersion, toVersion); mErr != nil {
+			return nil, mErr
+		}
+	}
+
+	var warnings admission.Warnings
+
+	for idx := range upgradePathRules {
+		rule := &upgradePathRules[idx]
+		if rule.transition != transition {
+			continue
+		}
+
+		crossed, cErr := isReleaseCrossed(lowVersion, highVersion, rule.release)
+		if cErr != nil {
+			return warnings, cErr
+		}
+
+		if !crossed {
+			continue
+		}
+
+		if rule.reject && (strongConsistency || !rule.strongConsistencyOnly) && !allowUnsafeDowngrade {
+			return warnings, fmt.Errorf(
+				"%s from version %s to %s is not supported: %s, set annotation %s to the new image to force it",
+				transition, fromVersion, toVersion, rule.message, AllowUnsafeDowngradeAnnotation,
+			)
+		}
+
+		warnings = append(
+			warnings, fmt.Sprintf("%s from version %s to %s: %s", transition, fromVersion, toVersion, rule.message),
+		)
+	}
+
+	return warnings, nil
+}
+
+// hasStrongConsistencyNamespace returns true if any namespace of the cluster has strong consistency enabled.
+func (c *AerospikeCluster) hasStrongConsistencyNamespace() bool {
+	for _, conf := range getNsConfForNamespaces(c.Spec.RackConfig) {
+		if conf.scEnabled {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isReleaseCrossed returns true if lowVersion < release <= highVersion.
+func isReleaseCrossed(lowVersion, highVersion, release string) (bool, error) {
+	lowVal, err := lib.CompareVersionsIgnoreRevision(lowVersion, release)
+	if err != nil {
+		return false, err
+	}
+
+	highVal, err := lib.CompareVersionsIgnoreRevision(highVersion, release)
+	if err != nil {
+		return false, err
+	}
+
+	return lowVal < 0 && highVal >= 0, nil
+}
+
+// validateMajorVersionJump validates that an upgrade does not skip major versions.
+func validateMajorVersionJump(fromVersion, toVersion string) error {
+	fromMajor, err := getMajorVersion(fromVersion)
+	if err != nil {
+		return err
+	}
+
+	toMajor, err := getMajorVersion(toVersion)
+	if err != nil {
+		return err
+	}
+
+	if toMajor-fromMajor > maxMajorVersionJump {
+		return fmt.Errorf(
+			"upgrade from version %s to %s is not supported: major versions cannot be skipped, "+
+				"upgrade to the latest %d.x version first", fromVersion, toVersion, fromMajor+1,
+		)
+	}
+
+	return nil
+}
+
+func getMajorVersion(version string) (int, error) {
+	major, err := strconv.Atoi(strings.Split(version, ".")[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %s: %v", version, err)
+	}
+
+	return major, nil
+}
@@ -0,0 +1,158 @@
+package v1
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsReleaseCrossed(t *testing.T) {
+	tests := []struct {
+		lowVersion  string
+		highVersion string
+		release     string
+		want        bool
+	}{
+		{"6.4.0.7", "7.0.0.20", "7.0", true},
+		{"6.4.0.7", "7.2.0.6", "7.0", true},
+		{"7.0.0.0", "7.2.0.6", "7.0", false},
+		{"6.3.0.13", "6.4.0.7", "7.0", false},
+		{"7.2.0.6", "8.0.0.2", "8.0", true},
+		{"8.0.0.1", "8.0.0.2", "8.0", false},
+		{"6.4.0.7", "8.0.0.2", "7.0", true},
+	}
+
+	for _, test := range tests {
+		got, err := isReleaseCrossed(test.lowVersion, test.highVersion, test.release)
+		if err != nil {
+			t.Fatalf("%s to %s crossing %s: unexpected error: %v", test.lowVersion, test.highVersion, test.release, err)
+		}
+
+		if got != test.want {
+			t.Errorf(
+				"%s to %s crossing %s: expected %v, got %v", test.lowVersion, test.highVersion, test.release,
+				test.want, got,
+			)
+		}
+	}
+}
+
+func TestIsReleaseCrossedInvalidVersion(t *testing.T) {
+	if _, err := isReleaseCrossed("invalid", "7.0.0.0", "7.0"); err == nil {
+		t.Errorf("expected error for invalid version")
+	}
+}
+
+func TestValidateVersionTransition(t *testing.T) {
+	tests := []struct {
+		name                 string
+		fromVersion          string
+		toVersion            string
+		strongConsistency    bool
+		allowUnsafeDowngrade bool
+		wantErr              string
+		wantWarnings         int
+	}{
+		{
+			name:        "same version",
+			fromVersion: "7.2.0.6",
+			toVersion:   "7.2.0.6",
+		},
+		{
+			name:        "patch upgrade",
+			fromVersion: "7.2.0.6",
+			toVersion:   "7.2.0.7",
+		},
+		{
+			name:        "minor upgrade",
+			fromVersion: "7.1.0.12",
+			toVersion:   "7.2.0.6",
+		},
+		{
+			name:         "upgrade crossing 7.0",
+			fromVersion:  "6.4.0.7",
+			toVersion:    "7.0.0.20",
+			wantWarnings: 1,
+		},
+		{
+			name:        "upgrade crossing 8.0",
+			fromVersion: "7.2.0.6",
+			toVersion:   "8.0.0.2",
+		},
+		{
+			name:        "upgrade skipping a major version",
+			fromVersion: "6.4.0.7",
+			toVersion:   "8.0.0.2",
+			wantErr:     "major versions cannot be skipped",
+		},
+		{
+			name:        "minor downgrade",
+			fromVersion: "7.2.0.6",
+			toVersion:   "7.1.0.12",
+		},
+		{
+			name:        "downgrade crossing 7.0",
+			fromVersion: "7.0.0.20",
+			toVersion:   "6.4.0.7",
+			wantErr:     AllowUnsafeDowngradeAnnotation,
+		},
+		{
+			name:                 "allowed downgrade crossing 7.0",
+			fromVersion:          "7.0.0.20",
+			toVersion:            "6.4.0.7",
+			allowUnsafeDowngrade: true,
+			wantWarnings:         1,
+		},
+		{
+			name:         "downgrade below 8.0",
+			fromVersion:  "8.0.0.2",
+			toVersion:    "7.2.0.6",
+			wantWarnings: 1,
+		},
+		{
+			name:              "downgrade below 8.0 with strong consistency",
+			fromVersion:       "8.0.0.2",
+			toVersion:         "7.2.0.6",
+			strongConsistency: true,
+			wantErr:           AllowUnsafeDowngradeAnnotation,
+		},
+		{
+			name:                 "allowed downgrade below 8.0 with strong consistency",
+			fromVersion:          "8.0.0.2",
+			toVersion:            "7.2.0.6",
+			strongConsistency:    true,
+			allowUnsafeDowngrade: true,
+			wantWarnings:         1,
+		},
+		{
+			name:                 "allowed downgrade below 8.0 and crossing 7.0",
+			fromVersion:          "8.0.0.2",
+			toVersion:            "6.4.0.7",
+			strongConsistency:    true,
+			allowUnsafeDowngrade: true,
+			wantWarnings:         2,
+		},
+	}
+
+	for _, test := range tests {
+		warnings, err := validateVersionTransition(
+			test.fromVersion, test.toVersion, test.strongConsistency, test.allowUnsafeDowngrade,
+		)
+
+		if test.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("%s: expected error containing %q, got %v", test.name, test.wantErr, err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		if len(warnings) != test.wantWarnings {
+			t.Errorf("%s: expected %d warnings, got %v", test.name, test.wantWarnings, warnings)
+		}
+	}
+}
@@ -6,10 +6,15 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	clientGoScheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	internalerrors "github.com/aerospike/aerospike-kubernetes-operator/errors"
 	lib "github.com/aerospike/aerospike-management-lib"
@@ -33,6 +38,28 @@ const (
 	InfoPortName = "info"
 )
 
+const (
+	// DefaultServerHealthRefreshPeriod is the default period of the server health snapshot refresh.
+	DefaultServerHealthRefreshPeriod = 60 * time.Second
+	// MinServerHealthRefreshPeriod is the minimum allowed period of the server health snapshot refresh.
+	MinServerHealthRefreshPeriod = 10 * time.Second
+)
+
+const (
+	// DefaultAutoscalingHighWatermarkPct is the default usage percentage at or above which the cluster is scaled up.
+	DefaultAutoscalingHighWatermarkPct = 80
+	// DefaultAutoscalingLowWatermarkPct is the default usage percentage below which the cluster is scaled down.
+	DefaultAutoscalingLowWatermarkPct = 40
+	// DefaultAutoscalingScaleUpCooldown is the default minimum time between the last scaling and a scale-up.
+	DefaultAutoscalingScaleUpCooldown = 5 * time.Minute
+	// DefaultAutoscalingScaleDownCooldown is the default minimum time between the last scaling and a scale-down.
+	DefaultAutoscalingScaleDownCooldown = 30 * time.Minute
+)
+
+// DefaultLocalPVRecoveryGracePeriod is the default minimum time a pod is unschedulable before its local PVCs are
+// deleted.
+const DefaultLocalPVRecoveryGracePeriod = 10 * time.Minute
+
 const (
 	baseVersion                  = "6.0.0.0"
 	baseInitVersion              = "1.0.0"
@@ -83,6 +110,14 @@ const (
 	AerospikeAPIVersion                            = "v1"
 )
 
+// Labels of the VolumeSnapshots taken by an AerospikeVolumeSnapshot, used to match them to the PVCs of a cluster
+// restored from spec.volumeSnapshotSource.
+const (
+	AerospikeVolumeSnapshotLabel = "asdb.aerospike.com/volume-snapshot"
+	AerospikeStorageVolumeLabel  = "asdb.aerospike.com/storage-volume"
+	AerospikePodOrdinalLabel     = "asdb.aerospike.com/pod-ordinal"
+)
+
 // ContainsString check whether list contains given string
 func ContainsString(list []string, ele string) bool {
 	for _, listEle := range list {
@@ -625,3 +660,26 @@ func GetAllPodNames(pods map[string]AerospikePodStatus) sets.Set[string] {
 
 	return podNames
 }
+
+// NewK8sClient returns a client with the client-go and asdbv1 schemes, and the schemes added by addToSchemes.
+// It is used by the webhooks to get the objects referenced by the validated objects.
+func NewK8sClient(addToSchemes ...func(*runtime.Scheme) error) (client.Client, error) {
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubernetes config: %v", err)
+	}
+
+	scheme := runtime.NewScheme()
+
+	for _, addToScheme := range append(
+		[]func(*runtime.Scheme) error{clientGoScheme.AddToScheme, AddToScheme}, addToSchemes...,
+	) {
+		if aErr := addToScheme(scheme); aErr != nil {
+			return nil, aErr
+		}
+	}
+
+	return client.New(restConfig, client.Options{
+		Scheme: scheme,
+	})
+}
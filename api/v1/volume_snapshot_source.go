@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"fmt"
+)
+
+// validateVolumeSnapshotSource validates that the persistent volumes restored from the volume snapshots are not
+// initialized.
+func (c *AerospikeCluster) validateVolumeSnapshotSource() error {
+	if c.Spec.VolumeSnapshotSource == "" {
+		return nil
+	}
+
+	storages := []*AerospikeStorageSpec{&c.Spec.Storage}
+	for idx := range c.Spec.RackConfig.Racks {
+		storages = append(storages, &c.Spec.RackConfig.Racks[idx].Storage)
+	}
+
+	for _, storage := range storages {
+		for idx := range storage.Volumes {
+			volume := &storage.Volumes[idx]
+			if volume.Source.PersistentVolume == nil {
+				continue
+			}
+
+			if volume.InitMethod != AerospikeVolumeMethodNone {
+				return fmt.Errorf(
+					"volume %s should have initMethod %s to be restored from spec.volumeSnapshotSource, found %s",
+					volume.Name, AerospikeVolumeMethodNone, volume.InitMethod,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateVolumeSnapshotSourceUpdate validates that the volume snapshot source is not set or changed after the
+// cluster is created.
+func (c *AerospikeCluster) validateVolumeSnapshotSourceUpdate(oldObj *AerospikeCluster) error {
+	if c.Spec.VolumeSnapshotSource != "" && c.Spec.VolumeSnapshotSource != oldObj.Spec.VolumeSnapshotSource {
+		return fmt.Errorf("spec.volumeSnapshotSource can be set only when the cluster is created")
+	}
+
+	return nil
+}
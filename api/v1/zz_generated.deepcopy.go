@@ -202,6 +202,11 @@ func (in *AerospikeClusterSpec) DeepCopyInto(out *AerospikeClusterSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ScaleDownSelection != nil {
+		in, out := &in.ScaleDownSelection, &out.ScaleDownSelection
+		*out = new(ScaleDownSelection)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Paused != nil {
 		in, out := &in.Paused, &out.Paused
 		*out = new(bool)
@@ -214,6 +219,45 @@ func (in *AerospikeClusterSpec) DeepCopyInto(out *AerospikeClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ServerHealth != nil {
+		in, out := &in.ServerHealth, &out.ServerHealth
+		*out = new(ServerHealthSpec)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageClassMigration != nil {
+		in, out := &in.StorageClassMigration, &out.StorageClassMigration
+		*out = new(StorageClassMigrationSpec)
+		**out = **in
+	}
+	if in.SecondaryIndexes != nil {
+		in, out := &in.SecondaryIndexes, &out.SecondaryIndexes
+		*out = make([]SecondaryIndexSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UDFModules != nil {
+		in, out := &in.UDFModules, &out.UDFModules
+		*out = make([]UDFModuleSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(UpgradeStrategySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeClusterSpec.
@@ -230,6 +274,102 @@ func (in *AerospikeClusterSpec) DeepCopy() *AerospikeClusterSpec {
 func (in *AerospikeClusterStatus) DeepCopyInto(out *AerospikeClusterStatus) {
 	*out = *in
 	in.AerospikeClusterStatusSpec.DeepCopyInto(&out.AerospikeClusterStatusSpec)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulReconcileTime != nil {
+		in, out := &in.LastSuccessfulReconcileTime, &out.LastSuccessfulReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Racks != nil {
+		in, out := &in.Racks, &out.Racks
+		*out = make([]RackStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServerHealth != nil {
+		in, out := &in.ServerHealth, &out.ServerHealth
+		*out = new(ServerHealthStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeExpansions != nil {
+		in, out := &in.VolumeExpansions, &out.VolumeExpansions
+		*out = make([]VolumeExpansionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageClassMigration != nil {
+		in, out := &in.StorageClassMigration, &out.StorageClassMigration
+		*out = new(StorageClassMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OperationHistory != nil {
+		in, out := &in.OperationHistory, &out.OperationHistory
+		*out = make([]OperationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(ReconcilePlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecondaryIndexes != nil {
+		in, out := &in.SecondaryIndexes, &out.SecondaryIndexes
+		*out = make([]SecondaryIndexStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.UDFModules != nil {
+		in, out := &in.UDFModules, &out.UDFModules
+		*out = make([]UDFModuleStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(ImageUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeRollback != nil {
+		in, out := &in.UpgradeRollback, &out.UpgradeRollback
+		*out = new(UpgradeRollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollingUpdatePause != nil {
+		in, out := &in.RollingUpdatePause, &out.RollingUpdatePause
+		*out = new(RollingUpdatePauseStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindowStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleDownSelection != nil {
+		in, out := &in.ScaleDownSelection, &out.ScaleDownSelection
+		*out = new(ScaleDownSelectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Pods != nil {
 		in, out := &in.Pods, &out.Pods
 		*out = make(map[string]AerospikePodStatus, len(*in))
@@ -730,6 +870,11 @@ func (in *AerospikeStorageSpec) DeepCopyInto(out *AerospikeStorageSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LocalPVRecovery != nil {
+		in, out := &in.LocalPVRecovery, &out.LocalPVRecovery
+		*out = new(LocalPVRecoverySpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]VolumeSpec, len(*in))
@@ -786,295 +931,1122 @@ func (in *AttachmentOptions) DeepCopy() *AttachmentOptions {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CaCertsSource) DeepCopyInto(out *CaCertsSource) {
+func (in *AutoscalingSpec) DeepCopyInto(out *AutoscalingSpec) {
 	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]AutoscalingMetric, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScaleUpCooldown != nil {
+		in, out := &in.ScaleUpCooldown, &out.ScaleUpCooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ScaleDownCooldown != nil {
+		in, out := &in.ScaleDownCooldown, &out.ScaleDownCooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaCertsSource.
-func (in *CaCertsSource) DeepCopy() *CaCertsSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingSpec.
+func (in *AutoscalingSpec) DeepCopy() *AutoscalingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CaCertsSource)
+	out := new(AutoscalingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+func (in *AutoscalingStatus) DeepCopyInto(out *AutoscalingStatus) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.UsagePct != nil {
+		in, out := &in.UsagePct, &out.UsagePct
+		*out = new(int32)
+		**out = **in
 	}
-	if in.LoadBalancerSourceRanges != nil {
-		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
-func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingStatus.
+func (in *AutoscalingStatus) DeepCopy() *AutoscalingStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerSpec)
+	out := new(AutoscalingStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MountOptions) DeepCopyInto(out *MountOptions) {
+func (in *CDTContextSpec) DeepCopyInto(out *CDTContextSpec) {
 	*out = *in
-	if in.MountPropagation != nil {
-		in, out := &in.MountPropagation, &out.MountPropagation
-		*out = new(corev1.MountPropagationMode)
-		**out = **in
-	}
+	out.Value = in.Value
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountOptions.
-func (in *MountOptions) DeepCopy() *MountOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDTContextSpec.
+func (in *CDTContextSpec) DeepCopy() *CDTContextSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MountOptions)
+	out := new(CDTContextSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OperationSpec) DeepCopyInto(out *OperationSpec) {
+func (in *CaCertsSource) DeepCopyInto(out *CaCertsSource) {
 	*out = *in
-	if in.PodList != nil {
-		in, out := &in.PodList, &out.PodList
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationSpec.
-func (in *OperationSpec) DeepCopy() *OperationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaCertsSource.
+func (in *CaCertsSource) DeepCopy() *CaCertsSource {
 	if in == nil {
 		return nil
 	}
-	out := new(OperationSpec)
+	out := new(CaCertsSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PersistentVolumeSpec) DeepCopyInto(out *PersistentVolumeSpec) {
+func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
 	*out = *in
-	in.AerospikeObjectMeta.DeepCopyInto(&out.AerospikeObjectMeta)
-	out.Size = in.Size.DeepCopy()
-	if in.AccessModes != nil {
-		in, out := &in.AccessModes, &out.AccessModes
-		*out = make([]corev1.PersistentVolumeAccessMode, len(*in))
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Selector != nil {
-		in, out := &in.Selector, &out.Selector
-		*out = new(metav1.LabelSelector)
-		(*in).DeepCopyInto(*out)
+	if in.SoakStartTime != nil {
+		in, out := &in.SoakStartTime, &out.SoakStartTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeSpec.
-func (in *PersistentVolumeSpec) DeepCopy() *PersistentVolumeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
+func (in *CanaryStatus) DeepCopy() *CanaryStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PersistentVolumeSpec)
+	out := new(CanaryStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Rack) DeepCopyInto(out *Rack) {
+func (in *CanaryUpgradeSpec) DeepCopyInto(out *CanaryUpgradeSpec) {
 	*out = *in
-	if in.InputAerospikeConfig != nil {
-		in, out := &in.InputAerospikeConfig, &out.InputAerospikeConfig
-		*out = (*in).DeepCopy()
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(intstr.IntOrString)
+		**out = **in
 	}
-	in.AerospikeConfig.DeepCopyInto(&out.AerospikeConfig)
-	if in.InputStorage != nil {
-		in, out := &in.InputStorage, &out.InputStorage
-		*out = new(AerospikeStorageSpec)
-		(*in).DeepCopyInto(*out)
+	if in.RackID != nil {
+		in, out := &in.RackID, &out.RackID
+		*out = new(int)
+		**out = **in
 	}
-	in.Storage.DeepCopyInto(&out.Storage)
-	if in.InputPodSpec != nil {
-		in, out := &in.InputPodSpec, &out.InputPodSpec
-		*out = new(RackPodSpec)
-		(*in).DeepCopyInto(*out)
+	out.SoakDuration = in.SoakDuration
+	if in.InfoChecks != nil {
+		in, out := &in.InfoChecks, &out.InfoChecks
+		*out = make([]InfoCommandCheck, len(*in))
+		copy(*out, *in)
 	}
-	in.PodSpec.DeepCopyInto(&out.PodSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rack.
-func (in *Rack) DeepCopy() *Rack {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryUpgradeSpec.
+func (in *CanaryUpgradeSpec) DeepCopy() *CanaryUpgradeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Rack)
+	out := new(CanaryUpgradeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RackConfig) DeepCopyInto(out *RackConfig) {
+func (in *DisruptiveWork) DeepCopyInto(out *DisruptiveWork) {
 	*out = *in
-	if in.Namespaces != nil {
-		in, out := &in.Namespaces, &out.Namespaces
+	*out = *in
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Racks != nil {
-		in, out := &in.Racks, &out.Racks
-		*out = make([]Rack, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.RollingUpdateBatchSize != nil {
-		in, out := &in.RollingUpdateBatchSize, &out.RollingUpdateBatchSize
-		*out = new(intstr.IntOrString)
-		**out = **in
-	}
-	if in.ScaleDownBatchSize != nil {
-		in, out := &in.ScaleDownBatchSize, &out.ScaleDownBatchSize
-		*out = new(intstr.IntOrString)
-		**out = **in
-	}
-	if in.MaxIgnorablePods != nil {
-		in, out := &in.MaxIgnorablePods, &out.MaxIgnorablePods
-		*out = new(intstr.IntOrString)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackConfig.
-func (in *RackConfig) DeepCopy() *RackConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisruptiveWork.
+func (in *DisruptiveWork) DeepCopy() *DisruptiveWork {
 	if in == nil {
 		return nil
 	}
-	out := new(RackConfig)
+	out := new(DisruptiveWork)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RackPodSpec) DeepCopyInto(out *RackPodSpec) {
+func (in *DynamicConfigChange) DeepCopyInto(out *DynamicConfigChange) {
 	*out = *in
-	in.SchedulingPolicy.DeepCopyInto(&out.SchedulingPolicy)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackPodSpec.
-func (in *RackPodSpec) DeepCopy() *RackPodSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicConfigChange.
+func (in *DynamicConfigChange) DeepCopy() *DynamicConfigChange {
 	if in == nil {
 		return nil
 	}
-	out := new(RackPodSpec)
+	out := new(DynamicConfigChange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SchedulingPolicy) DeepCopyInto(out *SchedulingPolicy) {
+func (in *ImageUpgradeStatus) DeepCopyInto(out *ImageUpgradeStatus) {
 	*out = *in
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(corev1.Affinity)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]corev1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingPolicy.
-func (in *SchedulingPolicy) DeepCopy() *SchedulingPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUpgradeStatus.
+func (in *ImageUpgradeStatus) DeepCopy() *ImageUpgradeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SchedulingPolicy)
+	out := new(ImageUpgradeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedsFinderServices) DeepCopyInto(out *SeedsFinderServices) {
+func (in *InfoCommandCheck) DeepCopyInto(out *InfoCommandCheck) {
 	*out = *in
-	if in.LoadBalancer != nil {
-		in, out := &in.LoadBalancer, &out.LoadBalancer
-		*out = new(LoadBalancerSpec)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedsFinderServices.
-func (in *SeedsFinderServices) DeepCopy() *SeedsFinderServices {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfoCommandCheck.
+func (in *InfoCommandCheck) DeepCopy() *InfoCommandCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedsFinderServices)
+	out := new(InfoCommandCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ValidationPolicySpec) DeepCopyInto(out *ValidationPolicySpec) {
+func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
 	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LoadBalancerSourceRanges != nil {
+		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationPolicySpec.
-func (in *ValidationPolicySpec) DeepCopy() *ValidationPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
+func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ValidationPolicySpec)
+	out := new(LoadBalancerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeAttachment) DeepCopyInto(out *VolumeAttachment) {
+func (in *LocalPVRecoverySpec) DeepCopyInto(out *LocalPVRecoverySpec) {
 	*out = *in
-	in.AttachmentOptions.DeepCopyInto(&out.AttachmentOptions)
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAttachment.
-func (in *VolumeAttachment) DeepCopy() *VolumeAttachment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalPVRecoverySpec.
+func (in *LocalPVRecoverySpec) DeepCopy() *LocalPVRecoverySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VolumeAttachment)
+	out := new(LocalPVRecoverySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
 	*out = *in
-	if in.EmptyDir != nil {
-		in, out := &in.EmptyDir, &out.EmptyDir
-		*out = new(corev1.EmptyDirVolumeSource)
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
+	*out = *in
+	*out = *in
+	if in.NextWindowStart != nil {
+		in, out := &in.NextWindowStart, &out.NextWindowStart
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingWork != nil {
+		in, out := &in.PendingWork, &out.PendingWork
+		*out = make([]DisruptiveWork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowStatus.
+func (in *MaintenanceWindowStatus) DeepCopy() *MaintenanceWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MountOptions) DeepCopyInto(out *MountOptions) {
+	*out = *in
+	if in.MountPropagation != nil {
+		in, out := &in.MountPropagation, &out.MountPropagation
+		*out = new(corev1.MountPropagationMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountOptions.
+func (in *MountOptions) DeepCopy() *MountOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MountOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceHealthStatus) DeepCopyInto(out *NamespaceHealthStatus) {
+	*out = *in
+	if in.MemoryUsedPct != nil {
+		in, out := &in.MemoryUsedPct, &out.MemoryUsedPct
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeviceUsedPct != nil {
+		in, out := &in.DeviceUsedPct, &out.DeviceUsedPct
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IndexUsedPct != nil {
+		in, out := &in.IndexUsedPct, &out.IndexUsedPct
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceHealthStatus.
+func (in *NamespaceHealthStatus) DeepCopy() *NamespaceHealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationSpec) DeepCopyInto(out *OperationSpec) {
+	*out = *in
+	if in.PodList != nil {
+		in, out := &in.PodList, &out.PodList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Truncate != nil {
+		in, out := &in.Truncate, &out.Truncate
+		*out = new(TruncateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationSpec.
+func (in *OperationSpec) DeepCopy() *OperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationStatus) DeepCopyInto(out *OperationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PodsCompleted != nil {
+		in, out := &in.PodsCompleted, &out.PodsCompleted
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TruncateLastUpdateTime != nil {
+		in, out := &in.TruncateLastUpdateTime, &out.TruncateLastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationStatus.
+func (in *OperationStatus) DeepCopy() *OperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeSpec) DeepCopyInto(out *PersistentVolumeSpec) {
+	*out = *in
+	in.AerospikeObjectMeta.DeepCopyInto(&out.AerospikeObjectMeta)
+	out.Size = in.Size.DeepCopy()
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]corev1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeSpec.
+func (in *PersistentVolumeSpec) DeepCopy() *PersistentVolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodPendingRestart) DeepCopyInto(out *PodPendingRestart) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPendingRestart.
+func (in *PodPendingRestart) DeepCopy() *PodPendingRestart {
+	if in == nil {
+		return nil
+	}
+	out := new(PodPendingRestart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodPlan) DeepCopyInto(out *PodPlan) {
+	*out = *in
+	if in.DynamicConfigChanges != nil {
+		in, out := &in.DynamicConfigChanges, &out.DynamicConfigChanges
+		*out = make([]DynamicConfigChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPlan.
+func (in *PodPlan) DeepCopy() *PodPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(PodPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rack) DeepCopyInto(out *Rack) {
+	*out = *in
+	if in.InputAerospikeConfig != nil {
+		in, out := &in.InputAerospikeConfig, &out.InputAerospikeConfig
+		*out = (*in).DeepCopy()
+	}
+	in.AerospikeConfig.DeepCopyInto(&out.AerospikeConfig)
+	if in.InputStorage != nil {
+		in, out := &in.InputStorage, &out.InputStorage
+		*out = new(AerospikeStorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.InputPodSpec != nil {
+		in, out := &in.InputPodSpec, &out.InputPodSpec
+		*out = new(RackPodSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.PodSpec.DeepCopyInto(&out.PodSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rack.
+func (in *Rack) DeepCopy() *Rack {
+	if in == nil {
+		return nil
+	}
+	out := new(Rack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackConfig) DeepCopyInto(out *RackConfig) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Racks != nil {
+		in, out := &in.Racks, &out.Racks
+		*out = make([]Rack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RollingUpdateBatchSize != nil {
+		in, out := &in.RollingUpdateBatchSize, &out.RollingUpdateBatchSize
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.ScaleDownBatchSize != nil {
+		in, out := &in.ScaleDownBatchSize, &out.ScaleDownBatchSize
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxIgnorablePods != nil {
+		in, out := &in.MaxIgnorablePods, &out.MaxIgnorablePods
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.RollingUpdateStrategy != nil {
+		in, out := &in.RollingUpdateStrategy, &out.RollingUpdateStrategy
+		*out = new(RackRollingUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollingUpdatePolicy != nil {
+		in, out := &in.RollingUpdatePolicy, &out.RollingUpdatePolicy
+		*out = new(RackRollingUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RebalancePolicy != nil {
+		in, out := &in.RebalancePolicy, &out.RebalancePolicy
+		*out = new(RackRebalancePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackConfig.
+func (in *RackConfig) DeepCopy() *RackConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RackConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackPlan) DeepCopyInto(out *RackPlan) {
+	*out = *in
+	if in.StatefulSetChanges != nil {
+		in, out := &in.StatefulSetChanges, &out.StatefulSetChanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make([]PodPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackPlan.
+func (in *RackPlan) DeepCopy() *RackPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(RackPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackPodSpec) DeepCopyInto(out *RackPodSpec) {
+	*out = *in
+	in.SchedulingPolicy.DeepCopyInto(&out.SchedulingPolicy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackPodSpec.
+func (in *RackPodSpec) DeepCopy() *RackPodSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RackPodSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackRebalancePolicy) DeepCopyInto(out *RackRebalancePolicy) {
+	*out = *in
+	if in.MaxDataInMotion != nil {
+		in, out := &in.MaxDataInMotion, &out.MaxDataInMotion
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackRebalancePolicy.
+func (in *RackRebalancePolicy) DeepCopy() *RackRebalancePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RackRebalancePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackRollingUpdatePolicy) DeepCopyInto(out *RackRollingUpdatePolicy) {
+	*out = *in
+	if in.PostBatchChecks != nil {
+		in, out := &in.PostBatchChecks, &out.PostBatchChecks
+		*out = make([]InfoCommandCheck, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackRollingUpdatePolicy.
+func (in *RackRollingUpdatePolicy) DeepCopy() *RackRollingUpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RackRollingUpdatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackRollingUpdateStrategy) DeepCopyInto(out *RackRollingUpdateStrategy) {
+	*out = *in
+	*out = *in
+	if in.RackOrder != nil {
+		in, out := &in.RackOrder, &out.RackOrder
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.PauseAfterRack != nil {
+		in, out := &in.PauseAfterRack, &out.PauseAfterRack
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackRollingUpdateStrategy.
+func (in *RackRollingUpdateStrategy) DeepCopy() *RackRollingUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RackRollingUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackStatus) DeepCopyInto(out *RackStatus) {
+	*out = *in
+	if in.PodsPendingRestart != nil {
+		in, out := &in.PodsPendingRestart, &out.PodsPendingRestart
+		*out = make([]PodPendingRestart, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackStatus.
+func (in *RackStatus) DeepCopy() *RackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcilePlan) DeepCopyInto(out *ReconcilePlan) {
+	*out = *in
+	in.PlannedTime.DeepCopyInto(&out.PlannedTime)
+	if in.Racks != nil {
+		in, out := &in.Racks, &out.Racks
+		*out = make([]RackPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcilePlan.
+func (in *ReconcilePlan) DeepCopy() *ReconcilePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcilePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingUpdatePauseStatus) DeepCopyInto(out *RollingUpdatePauseStatus) {
+	*out = *in
+	*out = *in
+	in.PausedUntil.DeepCopyInto(&out.PausedUntil)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdatePauseStatus.
+func (in *RollingUpdatePauseStatus) DeepCopy() *RollingUpdatePauseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingUpdatePauseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleDownSelection) DeepCopyInto(out *ScaleDownSelection) {
+	*out = *in
+	*out = *in
+	if in.PodNames != nil {
+		in, out := &in.PodNames, &out.PodNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.K8sNodeNames != nil {
+		in, out := &in.K8sNodeNames, &out.K8sNodeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleDownSelection.
+func (in *ScaleDownSelection) DeepCopy() *ScaleDownSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleDownSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleDownSelectionStatus) DeepCopyInto(out *ScaleDownSelectionStatus) {
+	*out = *in
+	*out = *in
+	if in.PodK8sNodes != nil {
+		in, out := &in.PodK8sNodes, &out.PodK8sNodes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleDownSelectionStatus.
+func (in *ScaleDownSelectionStatus) DeepCopy() *ScaleDownSelectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleDownSelectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingPolicy) DeepCopyInto(out *SchedulingPolicy) {
+	*out = *in
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingPolicy.
+func (in *SchedulingPolicy) DeepCopy() *SchedulingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryIndexSpec) DeepCopyInto(out *SecondaryIndexSpec) {
+	*out = *in
+	if in.Context != nil {
+		in, out := &in.Context, &out.Context
+		*out = make([]CDTContextSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryIndexSpec.
+func (in *SecondaryIndexSpec) DeepCopy() *SecondaryIndexSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryIndexSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryIndexStatus) DeepCopyInto(out *SecondaryIndexStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryIndexStatus.
+func (in *SecondaryIndexStatus) DeepCopy() *SecondaryIndexStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryIndexStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedsFinderServices) DeepCopyInto(out *SeedsFinderServices) {
+	*out = *in
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedsFinderServices.
+func (in *SeedsFinderServices) DeepCopy() *SeedsFinderServices {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedsFinderServices)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerHealthSpec) DeepCopyInto(out *ServerHealthSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerHealthSpec.
+func (in *ServerHealthSpec) DeepCopy() *ServerHealthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerHealthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerHealthStatus) DeepCopyInto(out *ServerHealthStatus) {
+	*out = *in
+	in.LastRefreshTime.DeepCopyInto(&out.LastRefreshTime)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespaceHealthStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.XDRDCs != nil {
+		in, out := &in.XDRDCs, &out.XDRDCs
+		*out = make([]XDRDCHealthStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerHealthStatus.
+func (in *ServerHealthStatus) DeepCopy() *ServerHealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassMigrationSpec) DeepCopyInto(out *StorageClassMigrationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassMigrationSpec.
+func (in *StorageClassMigrationSpec) DeepCopy() *StorageClassMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassMigrationStatus) DeepCopyInto(out *StorageClassMigrationStatus) {
+	*out = *in
+	if in.PendingPods != nil {
+		in, out := &in.PendingPods, &out.PendingPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassMigrationStatus.
+func (in *StorageClassMigrationStatus) DeepCopy() *StorageClassMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TruncateSpec) DeepCopyInto(out *TruncateSpec) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TruncateSpec.
+func (in *TruncateSpec) DeepCopy() *TruncateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TruncateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UDFModuleSource) DeepCopyInto(out *UDFModuleSource) {
+	*out = *in
+	if in.Modules != nil {
+		in, out := &in.Modules, &out.Modules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDFModuleSource.
+func (in *UDFModuleSource) DeepCopy() *UDFModuleSource {
+	if in == nil {
+		return nil
+	}
+	out := new(UDFModuleSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UDFModuleStatus) DeepCopyInto(out *UDFModuleStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDFModuleStatus.
+func (in *UDFModuleStatus) DeepCopy() *UDFModuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UDFModuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeRollbackSpec) DeepCopyInto(out *UpgradeRollbackSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeRollbackSpec.
+func (in *UpgradeRollbackSpec) DeepCopy() *UpgradeRollbackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeRollbackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeRollbackStatus) DeepCopyInto(out *UpgradeRollbackStatus) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeRollbackStatus.
+func (in *UpgradeRollbackStatus) DeepCopy() *UpgradeRollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeRollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStrategySpec) DeepCopyInto(out *UpgradeStrategySpec) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryUpgradeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpgradeRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStrategySpec.
+func (in *UpgradeStrategySpec) DeepCopy() *UpgradeStrategySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStrategySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidationPolicySpec) DeepCopyInto(out *ValidationPolicySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationPolicySpec.
+func (in *ValidationPolicySpec) DeepCopy() *ValidationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAttachment) DeepCopyInto(out *VolumeAttachment) {
+	*out = *in
+	in.AttachmentOptions.DeepCopyInto(&out.AttachmentOptions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAttachment.
+func (in *VolumeAttachment) DeepCopy() *VolumeAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeExpansionStatus) DeepCopyInto(out *VolumeExpansionStatus) {
+	*out = *in
+	out.RequestedSize = in.RequestedSize.DeepCopy()
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeExpansionStatus.
+func (in *VolumeExpansionStatus) DeepCopy() *VolumeExpansionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeExpansionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
+	*out = *in
+	if in.EmptyDir != nil {
+		in, out := &in.EmptyDir, &out.EmptyDir
+		*out = new(corev1.EmptyDirVolumeSource)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Secret != nil {
@@ -1087,6 +2059,26 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(corev1.ConfigMapVolumeSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Projected != nil {
+		in, out := &in.Projected, &out.Projected
+		*out = new(corev1.ProjectedVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CSI != nil {
+		in, out := &in.CSI, &out.CSI
+		*out = new(corev1.CSIVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ephemeral != nil {
+		in, out := &in.Ephemeral, &out.Ephemeral
+		*out = new(corev1.EphemeralVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostPath != nil {
+		in, out := &in.HostPath, &out.HostPath
+		*out = new(corev1.HostPathVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PersistentVolume != nil {
 		in, out := &in.PersistentVolume, &out.PersistentVolume
 		*out = new(PersistentVolumeSpec)
@@ -1139,3 +2131,18 @@ func (in *VolumeSpec) DeepCopy() *VolumeSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XDRDCHealthStatus) DeepCopyInto(out *XDRDCHealthStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XDRDCHealthStatus.
+func (in *XDRDCHealthStatus) DeepCopy() *XDRDCHealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(XDRDCHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+// +kubebuilder:validation:Enum=CreatingTarget;Replicating;AwaitingApproval;CuttingOver;Completed;Failed
+type AerospikeClusterMigrationPhase string
+
+// These are the valid phases of Aerospike cluster migration.
+const (
+	// AerospikeClusterMigrationCreatingTarget means the target cluster is being created.
+	AerospikeClusterMigrationCreatingTarget AerospikeClusterMigrationPhase = "CreatingTarget"
+
+	// AerospikeClusterMigrationReplicating means XDR is replicating the source cluster to the target cluster
+	// and the replication lag is above maxLag.
+	AerospikeClusterMigrationReplicating AerospikeClusterMigrationPhase = "Replicating"
+
+	// AerospikeClusterMigrationAwaitingApproval means the replication lag is within maxLag and the cutover waits
+	// for spec.approved.
+	AerospikeClusterMigrationAwaitingApproval AerospikeClusterMigrationPhase = "AwaitingApproval"
+
+	// AerospikeClusterMigrationCuttingOver means the source cluster is paused, its services are being moved to the
+	// target cluster and XDR is shipping the remaining records.
+	AerospikeClusterMigrationCuttingOver AerospikeClusterMigrationPhase = "CuttingOver"
+
+	// AerospikeClusterMigrationCompleted means the clients are served by the target cluster. The source cluster is
+	// kept paused, without the XDR DC, until it is decommissioned with spec.decommissionSource.
+	AerospikeClusterMigrationCompleted AerospikeClusterMigrationPhase = "Completed"
+
+	// AerospikeClusterMigrationFailed means the migration cannot proceed. The message has the reason.
+	AerospikeClusterMigrationFailed AerospikeClusterMigrationPhase = "Failed"
+)
+
+// AerospikeClusterMigrationSpec defines the desired state of AerospikeClusterMigration
+// +k8s:openapi-gen=true
+//
+//nolint:govet // for readability
+type AerospikeClusterMigrationSpec struct {
+	// SourceCluster is the name of the AerospikeCluster to migrate. It must be in the namespace of the migration.
+	// This field is immutable.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source Cluster"
+	SourceCluster string `json:"sourceCluster"`
+
+	// TargetCluster is the name of the AerospikeCluster created with targetSpec in the namespace of the migration.
+	// It must not exist already. This field is immutable.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Cluster"
+	TargetCluster string `json:"targetCluster"`
+
+	// TargetSpec is the spec of the target cluster, e.g. the source cluster spec with a different storage-engine,
+	// storage class or node ID scheme. It is validated by the AerospikeCluster webhook when the target cluster is
+	// created. XDR rewinds the replicated namespaces, so the existing records are shipped along with the new ones.
+	// This field is immutable.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Cluster Spec"
+	TargetSpec asdbv1.AerospikeClusterSpec `json:"targetSpec"`
+
+	// XDR configures the replication from the source cluster to the target cluster.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="XDR"
+	// +optional
+	XDR MigrationXDRSpec `json:"xdr,omitempty"`
+
+	// MaxLag is the replication lag within which the cutover is done. Default is 10 seconds.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Replication Lag"
+	// +optional
+	MaxLag metav1.Duration `json:"maxLag,omitempty"`
+
+	// Approved starts the cutover once the replication lag is within maxLag. The source cluster is paused and its
+	// seed and LoadBalancer services are moved to the target cluster pods. The XDR DC is removed from the source
+	// cluster once XDR has no records in queue and no pending recoveries. The source cluster is not deleted.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Approved"
+	// +optional
+	Approved bool `json:"approved,omitempty"`
+
+	// DecommissionSource deletes the source cluster once the migration is completed. Until then, the source
+	// cluster is kept paused so that the clients can be moved back to it.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Decommission Source"
+	// +optional
+	DecommissionSource bool `json:"decommissionSource,omitempty"`
+}
+
+// MigrationXDRSpec configures the XDR DC added to the source cluster to replicate to the target cluster.
+type MigrationXDRSpec struct {
+	// DCName is the name of the XDR DC added to the source cluster aerospikeConfig.
+	// Defaults to the target cluster name.
+	// +optional
+	DCName string `json:"dcName,omitempty"`
+
+	// Namespaces are the namespaces replicated to the target cluster.
+	// Defaults to all the namespaces of the source cluster.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// AuthUser is the user used by XDR to write to the target cluster, if security is enabled in it.
+	// +optional
+	AuthUser string `json:"authUser,omitempty"`
+
+	// AuthPasswordFile is the file in the source cluster pods having the password of authUser.
+	// +optional
+	AuthPasswordFile string `json:"authPasswordFile,omitempty"`
+}
+
+// AerospikeClusterMigrationStatus defines the observed state of AerospikeClusterMigration
+type AerospikeClusterMigrationStatus struct {
+	// Phase denotes the current phase of the migration.
+	// +optional
+	Phase AerospikeClusterMigrationPhase `json:"phase,omitempty"`
+
+	// Message is the detail of the current phase, e.g. the reason of the failure.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ReplicationLag is the XDR replication lag from the source cluster to the target cluster, as reported in the
+	// server health of the source cluster.
+	// +optional
+	ReplicationLag *metav1.Duration `json:"replicationLag,omitempty"`
+
+	// RecordsInQueue is the number of records waiting to be shipped to the target cluster.
+	// +optional
+	RecordsInQueue int64 `json:"recordsInQueue,omitempty"`
+
+	// LastLagUpdateTime is the time when the replication lag was last reported.
+	// +optional
+	LastLagUpdateTime *metav1.Time `json:"lastLagUpdateTime,omitempty"`
+
+	// CutoverTime is the time when the services were moved to the target cluster.
+	// +optional
+	CutoverTime *metav1.Time `json:"cutoverTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:annotations="aerospike-kubernetes-operator/version=4.0.1"
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceCluster`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.targetCluster`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Lag",type=string,JSONPath=`.status.replicationLag`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AerospikeClusterMigration is the Schema for the aerospikeclustermigrations API.
+// It migrates an AerospikeCluster to a new cluster created with a spec which cannot be applied in-place.
+//
+//nolint:govet // auto-generated
+type AerospikeClusterMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AerospikeClusterMigrationSpec   `json:"spec,omitempty"`
+	Status AerospikeClusterMigrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AerospikeClusterMigrationList contains a list of AerospikeClusterMigration
+type AerospikeClusterMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AerospikeClusterMigration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AerospikeClusterMigration{}, &AerospikeClusterMigrationList{})
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+// SetupAerospikeClusterMigrationWebhookWithManager registers the webhook for AerospikeClusterMigration in the manager.
+func SetupAerospikeClusterMigrationWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&AerospikeClusterMigration{}).
+		WithValidator(&AerospikeClusterMigrationCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:object:generate=false
+type AerospikeClusterMigrationCustomValidator struct {
+}
+
+//nolint:lll // for readability
+// +kubebuilder:webhook:path=/validate-asdb-aerospike-com-v1beta1-aerospikeclustermigration,mutating=false,failurePolicy=fail,sideEffects=None,groups=asdb.aerospike.com,resources=aerospikeclustermigrations,verbs=create;update,versions=v1beta1,name=vaerospikeclustermigration.kb.io,admissionReviewVersions=v1
+
+var _ webhook.CustomValidator = &AerospikeClusterMigrationCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (acmv *AerospikeClusterMigrationCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object,
+) (admission.Warnings, error) {
+	migration, ok := obj.(*AerospikeClusterMigration)
+	if !ok {
+		return nil, fmt.Errorf("expected AerospikeClusterMigration, got %T", obj)
+	}
+
+	acmLog := logf.Log.WithName(namespacedName(migration))
+
+	acmLog.Info("Validate create")
+
+	if err := migration.validateMigrationSpec(); err != nil {
+		return nil, err
+	}
+
+	k8sClient, gErr := getK8sClient()
+	if gErr != nil {
+		return nil, gErr
+	}
+
+	return nil, migration.validateMigrationClusters(k8sClient)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (acmv *AerospikeClusterMigrationCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object,
+) (admission.Warnings, error) {
+	migration, ok := newObj.(*AerospikeClusterMigration)
+	if !ok {
+		return nil, fmt.Errorf("expected AerospikeClusterMigration, got %T", newObj)
+	}
+
+	acmLog := logf.Log.WithName(namespacedName(migration))
+
+	acmLog.Info("Validate update")
+
+	oldMigration := oldObj.(*AerospikeClusterMigration)
+
+	if oldMigration.Spec.SourceCluster != migration.Spec.SourceCluster ||
+		oldMigration.Spec.TargetCluster != migration.Spec.TargetCluster {
+		return nil, fmt.Errorf("aerospikeClusterMigration sourceCluster and targetCluster are immutable")
+	}
+
+	if !reflect.DeepEqual(oldMigration.Spec.TargetSpec, migration.Spec.TargetSpec) {
+		return nil, fmt.Errorf("aerospikeClusterMigration targetSpec is immutable")
+	}
+
+	if !reflect.DeepEqual(oldMigration.Spec.XDR, migration.Spec.XDR) {
+		return nil, fmt.Errorf("aerospikeClusterMigration xdr is immutable")
+	}
+
+	return nil, migration.validateMigrationSpec()
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (acmv *AerospikeClusterMigrationCustomValidator) ValidateDelete(_ context.Context, obj runtime.Object,
+) (admission.Warnings, error) {
+	migration, ok := obj.(*AerospikeClusterMigration)
+	if !ok {
+		return nil, fmt.Errorf("expected AerospikeClusterMigration, got %T", obj)
+	}
+
+	acmLog := logf.Log.WithName(namespacedName(migration))
+
+	acmLog.Info("Validate delete")
+
+	return nil, nil
+}
+
+func (r *AerospikeClusterMigration) validateMigrationSpec() error {
+	if r.Spec.SourceCluster == "" || r.Spec.TargetCluster == "" {
+		return fmt.Errorf("sourceCluster and targetCluster are required")
+	}
+
+	if r.Spec.SourceCluster == r.Spec.TargetCluster {
+		return fmt.Errorf("sourceCluster and targetCluster cannot be the same")
+	}
+
+	if r.Spec.MaxLag.Duration < 0 {
+		return fmt.Errorf("maxLag cannot be negative")
+	}
+
+	if (r.Spec.XDR.AuthUser == "") != (r.Spec.XDR.AuthPasswordFile == "") {
+		return fmt.Errorf("xdr authUser and authPasswordFile should be given together")
+	}
+
+	if r.Spec.TargetSpec.AerospikeConfig == nil {
+		return fmt.Errorf("targetSpec.aerospikeConfig is required")
+	}
+
+	if r.Spec.DecommissionSource && !r.Spec.Approved {
+		return fmt.Errorf("decommissionSource cannot be set without approved")
+	}
+
+	return nil
+}
+
+// validateMigrationClusters validates that the source cluster exists and the target cluster does not exist.
+func (r *AerospikeClusterMigration) validateMigrationClusters(k8sClient client.Client) error {
+	sourceCluster := &asdbv1.AerospikeCluster{}
+
+	if err := k8sClient.Get(
+		context.TODO(), types.NamespacedName{Name: r.Spec.SourceCluster, Namespace: r.Namespace}, sourceCluster,
+	); err != nil {
+		return fmt.Errorf("failed to get source cluster %s: %v", r.Spec.SourceCluster, err)
+	}
+
+	sourceNamespaces := GetNamespaceNames(sourceCluster.Spec.AerospikeConfig)
+
+	for _, ns := range r.Spec.XDR.Namespaces {
+		if !slices.Contains(sourceNamespaces, ns) {
+			return fmt.Errorf("xdr namespace %s is not configured in source cluster %s", ns, r.Spec.SourceCluster)
+		}
+	}
+
+	targetCluster := &asdbv1.AerospikeCluster{}
+
+	err := k8sClient.Get(
+		context.TODO(), types.NamespacedName{Name: r.Spec.TargetCluster, Namespace: r.Namespace}, targetCluster,
+	)
+	if err == nil {
+		return fmt.Errorf("target cluster %s already exists", r.Spec.TargetCluster)
+	}
+
+	if !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get target cluster %s: %v", r.Spec.TargetCluster, err)
+	}
+
+	return nil
+}
+
+// GetNamespaceNames returns the names of the namespaces in the aerospikeConfig.
+func GetNamespaceNames(aeroConfig *asdbv1.AerospikeConfigSpec) []string {
+	if aeroConfig == nil {
+		return nil
+	}
+
+	nsList, ok := aeroConfig.Value["namespaces"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(nsList))
+
+	for _, nsConfInterface := range nsList {
+		if nsConf, ok := nsConfInterface.(map[string]interface{}); ok {
+			if name, ok := nsConf["name"].(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
+}
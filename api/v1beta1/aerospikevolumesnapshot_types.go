@@ -0,0 +1,166 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=InProgress;Ready;Failed
+type AerospikeVolumeSnapshotPhase string
+
+// These are the valid phases of Aerospike volume snapshot.
+const (
+	// AerospikeVolumeSnapshotInProgress means the VolumeSnapshots are being created or are not ready to use yet.
+	AerospikeVolumeSnapshotInProgress AerospikeVolumeSnapshotPhase = "InProgress"
+
+	// AerospikeVolumeSnapshotReady means all the VolumeSnapshots are ready to use.
+	AerospikeVolumeSnapshotReady AerospikeVolumeSnapshotPhase = "Ready"
+
+	// AerospikeVolumeSnapshotFailed means the snapshots cannot be taken. The message has the reason.
+	AerospikeVolumeSnapshotFailed AerospikeVolumeSnapshotPhase = "Failed"
+)
+
+// +kubebuilder:validation:Enum=None;Quiesce
+type VolumeSnapshotConsistency string
+
+const (
+	// VolumeSnapshotConsistencyNone snapshots the volumes of the running nodes, which can have writes in progress.
+	VolumeSnapshotConsistencyNone VolumeSnapshotConsistency = "None"
+
+	// VolumeSnapshotConsistencyQuiesce quiesces a node with a Quiesce operation of the cluster while its volumes are
+	// snapshotted, so that it is not the master of any partition. A quiesced node still takes the replica writes and
+	// the writes of the clients having a stale partition map. The nodes are quiesced one at a time.
+	VolumeSnapshotConsistencyQuiesce VolumeSnapshotConsistency = "Quiesce"
+)
+
+// AerospikeVolumeSnapshotSpec defines the desired state of AerospikeVolumeSnapshot
+// +k8s:openapi-gen=true
+//
+//nolint:govet // for readability
+type AerospikeVolumeSnapshotSpec struct {
+	// Cluster is the name of the AerospikeCluster whose volumes are snapshotted. It must be in the namespace of the
+	// snapshot. This field is immutable.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster"
+	Cluster string `json:"cluster"`
+
+	// Volumes are the names of the persistent volumes in the cluster storage to snapshot.
+	// Defaults to all the persistent volumes of the cluster. This field is immutable.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Volumes"
+	// +optional
+	Volumes []string `json:"volumes,omitempty"`
+
+	// VolumeSnapshotClassName is the VolumeSnapshotClass of the VolumeSnapshots.
+	// Defaults to the default VolumeSnapshotClass of the CSI driver. This field is immutable.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Volume Snapshot Class Name"
+	// +optional
+	VolumeSnapshotClassName string `json:"volumeSnapshotClassName,omitempty"`
+
+	// Consistency is the preparation of a node before its volumes are snapshotted. The nodes are snapshotted at
+	// different times, so the snapshots are not a point-in-time image of the cluster with any of the values.
+	// Defaults to None. This field is immutable.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Consistency"
+	// +optional
+	Consistency VolumeSnapshotConsistency `json:"consistency,omitempty"`
+}
+
+// VolumeSnapshotStatus is the status of a VolumeSnapshot of a cluster PVC.
+type VolumeSnapshotStatus struct { //nolint:govet // for readability
+	// Name is the name of the VolumeSnapshot.
+	Name string `json:"name"`
+
+	// PVCName is the name of the snapshotted PVC.
+	PVCName string `json:"pvcName"`
+
+	// PodName is the name of the pod of the PVC.
+	PodName string `json:"podName"`
+
+	// VolumeName is the name of the volume in the cluster storage.
+	VolumeName string `json:"volumeName"`
+
+	// RackID is the rack of the pod.
+	RackID int `json:"rackID"`
+
+	// ReadyToUse indicates if the VolumeSnapshot can be used to provision a PVC.
+	ReadyToUse bool `json:"readyToUse"`
+
+	// CreationTime is the time when the snapshot was taken by the storage system.
+	// +optional
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// RestoreSize is the minimum size of a PVC provisioned from the VolumeSnapshot.
+	// +optional
+	RestoreSize *resource.Quantity `json:"restoreSize,omitempty"`
+
+	// Error is the last error reported while taking the snapshot.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// AerospikeVolumeSnapshotStatus defines the observed state of AerospikeVolumeSnapshot
+type AerospikeVolumeSnapshotStatus struct {
+	// Phase denotes the current phase of the snapshot.
+	// +optional
+	Phase AerospikeVolumeSnapshotPhase `json:"phase,omitempty"`
+
+	// Message is the detail of the current phase, e.g. the reason of the failure.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Snapshots are the VolumeSnapshots created for the PVCs of the cluster.
+	// +optional
+	Snapshots []VolumeSnapshotStatus `json:"snapshots,omitempty"`
+
+	// CompletionTime is the time when all the VolumeSnapshots became ready to use.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:annotations="aerospike-kubernetes-operator/version=4.0.1"
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster`
+// +kubebuilder:printcolumn:name="Consistency",type=string,JSONPath=`.spec.consistency`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AerospikeVolumeSnapshot is the Schema for the aerospikevolumesnapshots API.
+// It takes CSI VolumeSnapshots of the persistent volumes of an AerospikeCluster. A new AerospikeCluster can be
+// provisioned from them with spec.volumeSnapshotSource.
+//
+//nolint:govet // auto-generated
+type AerospikeVolumeSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AerospikeVolumeSnapshotSpec   `json:"spec,omitempty"`
+	Status AerospikeVolumeSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AerospikeVolumeSnapshotList contains a list of AerospikeVolumeSnapshot
+type AerospikeVolumeSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AerospikeVolumeSnapshot `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AerospikeVolumeSnapshot{}, &AerospikeVolumeSnapshotList{})
+}
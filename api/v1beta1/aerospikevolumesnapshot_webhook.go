@@ -0,0 +1,160 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+// SetupAerospikeVolumeSnapshotWebhookWithManager registers the webhook for AerospikeVolumeSnapshot in the manager.
+func SetupAerospikeVolumeSnapshotWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&AerospikeVolumeSnapshot{}).
+		WithValidator(&AerospikeVolumeSnapshotCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:object:generate=false
+type AerospikeVolumeSnapshotCustomValidator struct {
+}
+
+//nolint:lll // for readability
+// +kubebuilder:webhook:path=/validate-asdb-aerospike-com-v1beta1-aerospikevolumesnapshot,mutating=false,failurePolicy=fail,sideEffects=None,groups=asdb.aerospike.com,resources=aerospikevolumesnapshots,verbs=create;update,versions=v1beta1,name=vaerospikevolumesnapshot.kb.io,admissionReviewVersions=v1
+
+var _ webhook.CustomValidator = &AerospikeVolumeSnapshotCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (avsv *AerospikeVolumeSnapshotCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object,
+) (admission.Warnings, error) {
+	snapshot, ok := obj.(*AerospikeVolumeSnapshot)
+	if !ok {
+		return nil, fmt.Errorf("expected AerospikeVolumeSnapshot, got %T", obj)
+	}
+
+	avsLog := logf.Log.WithName(namespacedName(snapshot))
+
+	avsLog.Info("Validate create")
+
+	if snapshot.Spec.Cluster == "" {
+		return nil, fmt.Errorf("cluster is required")
+	}
+
+	k8sClient, gErr := getK8sClient()
+	if gErr != nil {
+		return nil, gErr
+	}
+
+	return nil, snapshot.validateSnapshotCluster(k8sClient)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (avsv *AerospikeVolumeSnapshotCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object,
+) (admission.Warnings, error) {
+	snapshot, ok := newObj.(*AerospikeVolumeSnapshot)
+	if !ok {
+		return nil, fmt.Errorf("expected AerospikeVolumeSnapshot, got %T", newObj)
+	}
+
+	avsLog := logf.Log.WithName(namespacedName(snapshot))
+
+	avsLog.Info("Validate update")
+
+	oldSnapshot := oldObj.(*AerospikeVolumeSnapshot)
+
+	if !reflect.DeepEqual(oldSnapshot.Spec, snapshot.Spec) {
+		return nil, fmt.Errorf("aerospikeVolumeSnapshot spec is immutable")
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (avsv *AerospikeVolumeSnapshotCustomValidator) ValidateDelete(_ context.Context, obj runtime.Object,
+) (admission.Warnings, error) {
+	snapshot, ok := obj.(*AerospikeVolumeSnapshot)
+	if !ok {
+		return nil, fmt.Errorf("expected AerospikeVolumeSnapshot, got %T", obj)
+	}
+
+	avsLog := logf.Log.WithName(namespacedName(snapshot))
+
+	avsLog.Info("Validate delete")
+
+	return nil, nil
+}
+
+// validateSnapshotCluster validates that the cluster exists and has the persistent volumes to snapshot.
+func (r *AerospikeVolumeSnapshot) validateSnapshotCluster(k8sClient client.Client) error {
+	aeroCluster := &asdbv1.AerospikeCluster{}
+
+	if err := k8sClient.Get(
+		context.TODO(), types.NamespacedName{Name: r.Spec.Cluster, Namespace: r.Namespace}, aeroCluster,
+	); err != nil {
+		return fmt.Errorf("failed to get cluster %s: %v", r.Spec.Cluster, err)
+	}
+
+	pvNames := GetPersistentVolumeNames(aeroCluster)
+	if pvNames.Len() == 0 {
+		return fmt.Errorf("cluster %s has no persistent volumes", r.Spec.Cluster)
+	}
+
+	for _, volName := range r.Spec.Volumes {
+		if !pvNames.Has(volName) {
+			return fmt.Errorf("volume %s is not a persistent volume of cluster %s", volName, r.Spec.Cluster)
+		}
+	}
+
+	// A Quiesce operation cannot quiesce all the pods of the cluster.
+	if r.Spec.Consistency == VolumeSnapshotConsistencyQuiesce && aeroCluster.Spec.Size < 2 {
+		return fmt.Errorf("consistency %s needs a cluster of at least 2 pods", VolumeSnapshotConsistencyQuiesce)
+	}
+
+	return nil
+}
+
+// GetPersistentVolumeNames returns the names of the persistent volumes in the global and rack storage of the
+// cluster.
+func GetPersistentVolumeNames(aeroCluster *asdbv1.AerospikeCluster) sets.Set[string] {
+	pvNames := sets.New[string]()
+
+	storages := []*asdbv1.AerospikeStorageSpec{&aeroCluster.Spec.Storage}
+	for idx := range aeroCluster.Spec.RackConfig.Racks {
+		storages = append(storages, &aeroCluster.Spec.RackConfig.Racks[idx].Storage)
+	}
+
+	for _, storage := range storages {
+		for idx := range storage.Volumes {
+			if storage.Volumes[idx].Source.PersistentVolume != nil {
+				pvNames.Insert(storage.Volumes[idx].Name)
+			}
+		}
+	}
+
+	return pvNames
+}
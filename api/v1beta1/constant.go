@@ -26,3 +26,6 @@ const (
 	AerospikeBackupServiceKey = "aerospike-backup-service"
 	RefreshTimeKey            = AerospikeBackupServiceKey + "/last-refresh"
 )
+
+// AerospikeClusterMigrationLabel is set on the target cluster of an AerospikeClusterMigration to the migration name.
+const AerospikeClusterMigrationLabel = "asdb.aerospike.com/migration"
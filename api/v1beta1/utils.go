@@ -5,11 +5,7 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	utilRuntime "k8s.io/apimachinery/pkg/util/runtime"
-	clientGoScheme "k8s.io/client-go/kubernetes/scheme"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -26,22 +22,7 @@ func namespacedName(obj client.Object) string {
 }
 
 func getK8sClient() (client.Client, error) {
-	restConfig := ctrl.GetConfigOrDie()
-
-	scheme := runtime.NewScheme()
-
-	utilRuntime.Must(asdbv1.AddToScheme(scheme))
-	utilRuntime.Must(clientGoScheme.AddToScheme(scheme))
-	utilRuntime.Must(AddToScheme(scheme))
-
-	cl, err := client.New(restConfig, client.Options{
-		Scheme: scheme,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	return cl, nil
+	return asdbv1.NewK8sClient(AddToScheme)
 }
 
 func getBackupServiceFullConfig(k8sClient client.Client, name, namespace string) (*dto.Config, error) {
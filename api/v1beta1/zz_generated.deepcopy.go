@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -255,6 +256,111 @@ func (in *AerospikeBackupStatus) DeepCopy() *AerospikeBackupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AerospikeClusterMigration) DeepCopyInto(out *AerospikeClusterMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeClusterMigration.
+func (in *AerospikeClusterMigration) DeepCopy() *AerospikeClusterMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(AerospikeClusterMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AerospikeClusterMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AerospikeClusterMigrationList) DeepCopyInto(out *AerospikeClusterMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AerospikeClusterMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeClusterMigrationList.
+func (in *AerospikeClusterMigrationList) DeepCopy() *AerospikeClusterMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(AerospikeClusterMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AerospikeClusterMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AerospikeClusterMigrationSpec) DeepCopyInto(out *AerospikeClusterMigrationSpec) {
+	*out = *in
+	in.TargetSpec.DeepCopyInto(&out.TargetSpec)
+	in.XDR.DeepCopyInto(&out.XDR)
+	out.MaxLag = in.MaxLag
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeClusterMigrationSpec.
+func (in *AerospikeClusterMigrationSpec) DeepCopy() *AerospikeClusterMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AerospikeClusterMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AerospikeClusterMigrationStatus) DeepCopyInto(out *AerospikeClusterMigrationStatus) {
+	*out = *in
+	if in.ReplicationLag != nil {
+		in, out := &in.ReplicationLag, &out.ReplicationLag
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LastLagUpdateTime != nil {
+		in, out := &in.LastLagUpdateTime, &out.LastLagUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CutoverTime != nil {
+		in, out := &in.CutoverTime, &out.CutoverTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeClusterMigrationStatus.
+func (in *AerospikeClusterMigrationStatus) DeepCopy() *AerospikeClusterMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AerospikeClusterMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AerospikeObjectMeta) DeepCopyInto(out *AerospikeObjectMeta) {
 	*out = *in
@@ -382,6 +488,111 @@ func (in *AerospikeRestoreStatus) DeepCopy() *AerospikeRestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AerospikeVolumeSnapshot) DeepCopyInto(out *AerospikeVolumeSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeVolumeSnapshot.
+func (in *AerospikeVolumeSnapshot) DeepCopy() *AerospikeVolumeSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(AerospikeVolumeSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AerospikeVolumeSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AerospikeVolumeSnapshotList) DeepCopyInto(out *AerospikeVolumeSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AerospikeVolumeSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeVolumeSnapshotList.
+func (in *AerospikeVolumeSnapshotList) DeepCopy() *AerospikeVolumeSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(AerospikeVolumeSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AerospikeVolumeSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AerospikeVolumeSnapshotSpec) DeepCopyInto(out *AerospikeVolumeSnapshotSpec) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeVolumeSnapshotSpec.
+func (in *AerospikeVolumeSnapshotSpec) DeepCopy() *AerospikeVolumeSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AerospikeVolumeSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AerospikeVolumeSnapshotStatus) DeepCopyInto(out *AerospikeVolumeSnapshotStatus) {
+	*out = *in
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]VolumeSnapshotStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeVolumeSnapshotStatus.
+func (in *AerospikeVolumeSnapshotStatus) DeepCopy() *AerospikeVolumeSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AerospikeVolumeSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupService) DeepCopyInto(out *BackupService) {
 	*out = *in
@@ -397,6 +608,26 @@ func (in *BackupService) DeepCopy() *BackupService {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationXDRSpec) DeepCopyInto(out *MigrationXDRSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationXDRSpec.
+func (in *MigrationXDRSpec) DeepCopy() *MigrationXDRSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationXDRSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OnDemandBackupSpec) DeepCopyInto(out *OnDemandBackupSpec) {
 	*out = *in
@@ -525,3 +756,27 @@ func (in *ServicePodSpec) DeepCopy() *ServicePodSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotStatus) DeepCopyInto(out *VolumeSnapshotStatus) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RestoreSize != nil {
+		in, out := &in.RestoreSize, &out.RestoreSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotStatus.
+func (in *VolumeSnapshotStatus) DeepCopy() *VolumeSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
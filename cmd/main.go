@@ -36,7 +36,9 @@ import (
 	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/backup"
 	backupservice "github.com/aerospike/aerospike-kubernetes-operator/internal/controller/backup-service"
 	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/cluster"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/migration"
 	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/restore"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/volumesnapshot"
 	"github.com/aerospike/aerospike-kubernetes-operator/pkg/configschema"
 	"github.com/aerospike/aerospike-management-lib/asconfig"
 )
@@ -338,6 +340,40 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&migration.AerospikeClusterMigrationReconciler{
+		Client: client,
+		Scheme: mgr.GetScheme(),
+		Log:    ctrl.Log.WithName("controller").WithName("AerospikeClusterMigration"),
+		Recorder: eventBroadcaster.NewRecorder(
+			mgr.GetScheme(), v1.EventSource{Component: "aerospikeClusterMigration-controller"},
+		),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AerospikeClusterMigration")
+		os.Exit(1)
+	}
+
+	if err = asdbv1beta1.SetupAerospikeClusterMigrationWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AerospikeClusterMigration")
+		os.Exit(1)
+	}
+
+	if err = (&volumesnapshot.AerospikeVolumeSnapshotReconciler{
+		Client: client,
+		Scheme: mgr.GetScheme(),
+		Log:    ctrl.Log.WithName("controller").WithName("AerospikeVolumeSnapshot"),
+		Recorder: eventBroadcaster.NewRecorder(
+			mgr.GetScheme(), v1.EventSource{Component: "aerospikeVolumeSnapshot-controller"},
+		),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AerospikeVolumeSnapshot")
+		os.Exit(1)
+	}
+
+	if err = asdbv1beta1.SetupAerospikeVolumeSnapshotWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AerospikeVolumeSnapshot")
+		os.Exit(1)
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	if metricsCertWatcher != nil {
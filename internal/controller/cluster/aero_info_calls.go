@@ -19,6 +19,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	as "github.com/aerospike/aerospike-client-go/v7"
@@ -140,9 +141,27 @@ func (r *SingleClusterReconciler) waitForClusterStability(
 	}
 
 	if !isStable {
+		if err := r.setStatusConditions(
+			r.newCondition(
+				asdbv1.ConditionTypeMigrationsPending, metav1.ConditionTrue,
+				asdbv1.ConditionReasonMigrationsInProgress, "Waiting for partition migrations to complete",
+			),
+		); err != nil {
+			r.Log.Error(err, "Failed to set MigrationsPending condition")
+		}
+
 		return common.ReconcileRequeueAfter(60)
 	}
 
+	if err := r.setStatusConditions(
+		r.newCondition(
+			asdbv1.ConditionTypeMigrationsPending, metav1.ConditionFalse,
+			asdbv1.ConditionReasonMigrationsComplete, "No partition migrations pending",
+		),
+	); err != nil {
+		r.Log.Error(err, "Failed to set MigrationsPending condition")
+	}
+
 	return common.ReconcileSuccess()
 }
 
@@ -252,6 +271,32 @@ func (r *SingleClusterReconciler) newAsConn(pod *corev1.Pod) *deployment.ASConn
 	return asConn
 }
 
+// newAerospikeClient creates an aerospike client connected to the given hosts.
+// The client should be closed by the caller.
+func (r *SingleClusterReconciler) newAerospikeClient(hostConns []*deployment.HostConn) (*as.Client, error) {
+	hosts := make([]*as.Host, 0, len(hostConns))
+
+	for _, conn := range hostConns {
+		hosts = append(
+			hosts, &as.Host{
+				Name:    conn.ASConn.AerospikeHostName,
+				TLSName: conn.ASConn.AerospikeTLSName,
+				Port:    conn.ASConn.AerospikePort,
+			},
+		)
+	}
+
+	// Create policy using status, status has current connection info
+	clientPolicy := r.getClientPolicy()
+
+	aeroClient, err := as.NewClientWithPolicyAndHost(clientPolicy, hosts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create aerospike cluster client: %v", err)
+	}
+
+	return aeroClient, nil
+}
+
 func hostID(hostName string, hostPort int) string {
 	return fmt.Sprintf("%s:%d", hostName, hostPort)
 }
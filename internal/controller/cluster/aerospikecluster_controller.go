@@ -2,9 +2,11 @@ package cluster
 
 import (
 	"context"
+	"sync"
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	k8sRuntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
@@ -15,6 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -33,12 +36,22 @@ type AerospikeClusterReconciler struct {
 	KubeConfig *rest.Config
 	Scheme     *k8sRuntime.Scheme
 	Log        logr.Logger
+
+	// serverHealthRefreshes has the time of the server health refresh requeued for each cluster.
+	serverHealthRefreshes sync.Map
 }
 
 // SetupWithManager sets up the controller with the Manager
 func (r *AerospikeClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&asdbv1.AerospikeCluster{}).
+		For(
+			&asdbv1.AerospikeCluster{}, builder.WithPredicates(
+				predicate.Or(
+					predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}, canaryApprovalChanged,
+					refreshServerHealthWhilePausedChanged,
+				),
+			),
+		).
 		Owns(
 			&appsv1.StatefulSet{}, builder.WithPredicates(
 				predicate.Funcs{
@@ -51,15 +64,62 @@ func (r *AerospikeClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				},
 			),
 		).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.udfModuleConfigMapToClusters)).
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.MaxConcurrentReconciles,
 			},
 		).
-		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})).
 		Complete(r)
 }
 
+// udfModuleConfigMapToClusters maps a ConfigMap to the reconcile requests of the clusters in its namespace which
+// reference it in spec.udfModules, so UDF module changes are registered without waiting for another cluster change.
+func (r *AerospikeClusterReconciler) udfModuleConfigMapToClusters(
+	ctx context.Context, configMap client.Object,
+) []reconcile.Request {
+	clusterList := &asdbv1.AerospikeClusterList{}
+	if err := r.Client.List(ctx, clusterList, client.InNamespace(configMap.GetNamespace())); err != nil {
+		r.Log.Error(err, "Failed to list AerospikeClusters", "namespace", configMap.GetNamespace())
+		return nil
+	}
+
+	var requests []reconcile.Request
+
+	for idx := range clusterList.Items {
+		for _, udfModule := range clusterList.Items[idx].Spec.UDFModules {
+			if udfModule.ConfigMapName == configMap.GetName() {
+				requests = append(
+					requests, reconcile.Request{
+						NamespacedName: client.ObjectKeyFromObject(&clusterList.Items[idx]),
+					},
+				)
+
+				break
+			}
+		}
+	}
+
+	return requests
+}
+
+// canaryApprovalChanged triggers the reconcile when the canary upgrade approval annotation is changed.
+var canaryApprovalChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return e.ObjectOld.GetAnnotations()[asdbv1.CanaryApprovalAnnotation] !=
+			e.ObjectNew.GetAnnotations()[asdbv1.CanaryApprovalAnnotation]
+	},
+}
+
+// refreshServerHealthWhilePausedChanged triggers the reconcile when the annotation to refresh the server health
+// while the reconciliation is paused is changed.
+var refreshServerHealthWhilePausedChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return e.ObjectOld.GetAnnotations()[asdbv1.RefreshServerHealthWhilePausedAnnotation] !=
+			e.ObjectNew.GetAnnotations()[asdbv1.RefreshServerHealthWhilePausedAnnotation]
+	},
+}
+
 // RackState contains the rack configuration and rack size.
 type RackState struct {
 	Rack *asdbv1.Rack
@@ -70,11 +130,15 @@ type RackState struct {
 // +kubebuilder:rbac:groups=core,resources=pods/exec,verbs=create
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=persistentvolumes,verbs=get
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list
 // +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;create;update;patch;delete
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list
 //nolint:lll // marker
 // +kubebuilder:rbac:groups=asdb.aerospike.com,resources=aerospikeclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=asdb.aerospike.com,resources=aerospikeclusters/status,verbs=get;update;patch
@@ -93,6 +157,7 @@ func (r *AerospikeClusterReconciler) Reconcile(
 	if err := r.Client.Get(context.TODO(), request.NamespacedName, aeroCluster); err != nil {
 		if errors.IsNotFound(err) {
 			// Request object not found, could have been deleted after Reconcile request.
+			r.serverHealthRefreshes.Delete(request.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -107,6 +172,8 @@ func (r *AerospikeClusterReconciler) Reconcile(
 		Log:         log,
 		Scheme:      r.Scheme,
 		Recorder:    r.Recorder,
+
+		serverHealthRefreshes: &r.serverHealthRefreshes,
 	}
 
 	return cr.Reconcile()
@@ -0,0 +1,228 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+// reconcileAutoscaling changes spec.size based on the namespace usage in the server health snapshot, if
+// spec.autoscaling is set. The usage is evaluated only when the cluster is stable, i.e. all the nodes responded to
+// the info calls and no migrations are pending.
+func (r *SingleClusterReconciler) reconcileAutoscaling(serverHealth *asdbv1.ServerHealthStatus) error {
+	autoscaling := r.aeroCluster.Spec.Autoscaling
+	if autoscaling == nil {
+		if r.aeroCluster.Status.Autoscaling == nil {
+			return nil
+		}
+
+		return r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+			status.Autoscaling = nil
+		})
+	}
+
+	if serverHealth.Error != "" || serverHealth.NodesResponded != r.aeroCluster.Spec.Size ||
+		serverHealth.MigrateTxPartitionsRemaining+serverHealth.MigrateRxPartitionsRemaining != 0 {
+		r.Log.Info("Skipping autoscaling as the cluster is not stable")
+		return nil
+	}
+
+	usagePct, ok := getAutoscalingUsagePct(autoscaling.Metrics, serverHealth.Namespaces)
+	if !ok {
+		r.Log.Info("Skipping autoscaling as no usage is reported for the metrics", "metrics", autoscaling.Metrics)
+		return nil
+	}
+
+	size := r.aeroCluster.Spec.Size
+	newSize, decision := r.getAutoscalingSize(usagePct)
+
+	autoscalingStatus := &asdbv1.AutoscalingStatus{
+		UsagePct:     &usagePct,
+		LastDecision: decision,
+	}
+
+	if r.aeroCluster.Status.Autoscaling != nil {
+		autoscalingStatus.LastScaleTime = r.aeroCluster.Status.Autoscaling.LastScaleTime
+	}
+
+	if newSize != size {
+		r.Log.Info("Autoscaling the cluster", "size", size, "newSize", newSize, "usagePct", usagePct)
+
+		updated, err := r.updateClusterSize(newSize)
+		if err != nil {
+			return fmt.Errorf("failed to update cluster size: %v", err)
+		}
+
+		if !updated {
+			r.Log.Info("Skipping autoscaling as the cluster spec changed since the usage was evaluated")
+			return nil
+		}
+
+		now := metav1.Now()
+		autoscalingStatus.LastScaleTime = &now
+
+		reason := "AutoscaledUp"
+		if newSize < size {
+			reason = "AutoscaledDown"
+		}
+
+		r.Recorder.Event(r.aeroCluster, corev1.EventTypeNormal, reason, decision)
+	}
+
+	if reflect.DeepEqual(autoscalingStatus, r.aeroCluster.Status.Autoscaling) {
+		return nil
+	}
+
+	return r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.Autoscaling = autoscalingStatus
+	})
+}
+
+// getAutoscalingSize returns the cluster size for the usage, along with the decision taken.
+func (r *SingleClusterReconciler) getAutoscalingSize(usagePct int32) (int32, string) {
+	autoscaling := r.aeroCluster.Spec.Autoscaling
+	size := r.aeroCluster.Spec.Size
+
+	stepSize := autoscaling.StepSize
+	if stepSize == 0 {
+		stepSize = int32(max(len(r.aeroCluster.Spec.RackConfig.Racks), 1))
+	}
+
+	var lastScaleTime time.Time
+
+	if r.aeroCluster.Status.Autoscaling != nil && r.aeroCluster.Status.Autoscaling.LastScaleTime != nil {
+		lastScaleTime = r.aeroCluster.Status.Autoscaling.LastScaleTime.Time
+	}
+
+	switch {
+	case usagePct >= autoscaling.HighWatermarkPct:
+		if size >= autoscaling.MaxSize {
+			return size, fmt.Sprintf(
+				"usage %d%% is at or above high watermark %d%%, cluster is at maxSize %d", usagePct,
+				autoscaling.HighWatermarkPct, autoscaling.MaxSize,
+			)
+		}
+
+		if remaining := time.Until(lastScaleTime.Add(autoscaling.ScaleUpCooldown.Duration)); remaining > 0 {
+			return size, fmt.Sprintf(
+				"usage %d%% is at or above high watermark %d%%, scale-up waits %s for cooldown", usagePct,
+				autoscaling.HighWatermarkPct, remaining.Round(time.Second),
+			)
+		}
+
+		newSize := min(size+stepSize, autoscaling.MaxSize)
+
+		return newSize, fmt.Sprintf(
+			"scaled up from %d to %d, usage %d%% is at or above high watermark %d%%", size, newSize, usagePct,
+			autoscaling.HighWatermarkPct,
+		)
+
+	case usagePct < autoscaling.LowWatermarkPct:
+		if size <= autoscaling.MinSize {
+			return size, fmt.Sprintf(
+				"usage %d%% is below low watermark %d%%, cluster is at minSize %d", usagePct,
+				autoscaling.LowWatermarkPct, autoscaling.MinSize,
+			)
+		}
+
+		newSize := max(size-stepSize, autoscaling.MinSize)
+
+		// The data is assumed to be evenly distributed across the nodes.
+		if newUsagePct := int32(math.Ceil(float64(usagePct*size) / float64(newSize))); newUsagePct >=
+			autoscaling.HighWatermarkPct {
+			return size, fmt.Sprintf(
+				"usage %d%% is below low watermark %d%%, scale-down to %d skipped as usage would be %d%%", usagePct,
+				autoscaling.LowWatermarkPct, newSize, newUsagePct,
+			)
+		}
+
+		if remaining := time.Until(lastScaleTime.Add(autoscaling.ScaleDownCooldown.Duration)); remaining > 0 {
+			return size, fmt.Sprintf(
+				"usage %d%% is below low watermark %d%%, scale-down waits %s for cooldown", usagePct,
+				autoscaling.LowWatermarkPct, remaining.Round(time.Second),
+			)
+		}
+
+		return newSize, fmt.Sprintf(
+			"scaled down from %d to %d, usage %d%% is below low watermark %d%%", size, newSize, usagePct,
+			autoscaling.LowWatermarkPct,
+		)
+	}
+
+	return size, fmt.Sprintf(
+		"usage %d%% is between low watermark %d%% and high watermark %d%%", usagePct,
+		autoscaling.LowWatermarkPct, autoscaling.HighWatermarkPct,
+	)
+}
+
+// getAutoscalingUsagePct returns the highest usage of the metrics across the namespaces. It returns false if no
+// usage is reported for the metrics.
+func getAutoscalingUsagePct(
+	metrics []asdbv1.AutoscalingMetric, namespaces []asdbv1.NamespaceHealthStatus,
+) (int32, bool) {
+	var (
+		usagePct int32
+		found    bool
+	)
+
+	for idx := range namespaces {
+		for _, metric := range metrics {
+			var metricPct *int32
+
+			switch metric {
+			case asdbv1.AutoscalingMetricMemory:
+				metricPct = namespaces[idx].MemoryUsedPct
+			case asdbv1.AutoscalingMetricDevice:
+				metricPct = namespaces[idx].DeviceUsedPct
+			case asdbv1.AutoscalingMetricIndex:
+				metricPct = namespaces[idx].IndexUsedPct
+			}
+
+			if metricPct != nil {
+				usagePct = max(usagePct, *metricPct)
+				found = true
+			}
+		}
+	}
+
+	return usagePct, found
+}
+
+// updateClusterSize patches spec.size of the latest AerospikeCluster object. The patch is retried on conflicts
+// with status updates, and is not applied if the spec of the reconciled object has been changed meanwhile.
+func (r *SingleClusterReconciler) updateClusterSize(size int32) (bool, error) {
+	var updated bool
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestAeroCluster := &asdbv1.AerospikeCluster{}
+		if err := r.Client.Get(
+			context.TODO(), utils.GetNamespacedName(r.aeroCluster), latestAeroCluster,
+		); err != nil {
+			return err
+		}
+
+		if latestAeroCluster.Generation != r.aeroCluster.Generation {
+			updated = false
+			return nil
+		}
+
+		patch := client.MergeFromWithOptions(latestAeroCluster.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		latestAeroCluster.Spec.Size = size
+		updated = true
+
+		return r.Client.Patch(context.TODO(), latestAeroCluster, patch, common.PatchOption)
+	})
+
+	return updated, err
+}
@@ -0,0 +1,211 @@
+package cluster
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+func TestGetAutoscalingSize(t *testing.T) {
+	tests := []struct {
+		name         string
+		size         int32
+		stepSize     int32
+		racks        int
+		usagePct     int32
+		lastScaleAgo time.Duration
+		wantSize     int32
+		wantDecision string
+	}{
+		{
+			name:         "usage between the watermarks",
+			size:         4,
+			usagePct:     60,
+			wantSize:     4,
+			wantDecision: "between low watermark 40% and high watermark 80%",
+		},
+		{
+			name:         "scale up",
+			size:         4,
+			stepSize:     1,
+			usagePct:     80,
+			wantSize:     5,
+			wantDecision: "scaled up from 4 to 5",
+		},
+		{
+			name:         "scale up by the number of racks",
+			size:         4,
+			racks:        2,
+			usagePct:     85,
+			lastScaleAgo: time.Hour,
+			wantSize:     6,
+			wantDecision: "scaled up from 4 to 6",
+		},
+		{
+			name:         "scale up limited by maxSize",
+			size:         5,
+			stepSize:     2,
+			usagePct:     85,
+			wantSize:     6,
+			wantDecision: "scaled up from 5 to 6",
+		},
+		{
+			name:         "scale up at maxSize",
+			size:         6,
+			usagePct:     90,
+			wantSize:     6,
+			wantDecision: "cluster is at maxSize 6",
+		},
+		{
+			name:         "scale up in cooldown",
+			size:         4,
+			usagePct:     85,
+			lastScaleAgo: time.Minute,
+			wantSize:     4,
+			wantDecision: "scale-up waits",
+		},
+		{
+			name:         "scale down",
+			size:         4,
+			stepSize:     1,
+			usagePct:     20,
+			lastScaleAgo: time.Hour,
+			wantSize:     3,
+			wantDecision: "scaled down from 4 to 3",
+		},
+		{
+			name:         "scale down limited by minSize",
+			size:         3,
+			stepSize:     2,
+			usagePct:     20,
+			wantSize:     2,
+			wantDecision: "scaled down from 3 to 2",
+		},
+		{
+			name:         "scale down at minSize",
+			size:         2,
+			usagePct:     10,
+			wantSize:     2,
+			wantDecision: "cluster is at minSize 2",
+		},
+		{
+			name:         "scale down reaching the high watermark",
+			size:         6,
+			stepSize:     4,
+			usagePct:     39,
+			wantSize:     6,
+			wantDecision: "scale-down to 2 skipped as usage would be 117%",
+		},
+		{
+			name:         "scale down in cooldown",
+			size:         4,
+			usagePct:     20,
+			lastScaleAgo: 10 * time.Minute,
+			wantSize:     4,
+			wantDecision: "scale-down waits",
+		},
+	}
+
+	for _, test := range tests {
+		aeroCluster := &asdbv1.AerospikeCluster{
+			Spec: asdbv1.AerospikeClusterSpec{
+				Size: test.size,
+				Autoscaling: &asdbv1.AutoscalingSpec{
+					MinSize:           2,
+					MaxSize:           6,
+					HighWatermarkPct:  80,
+					LowWatermarkPct:   40,
+					StepSize:          test.stepSize,
+					ScaleUpCooldown:   &metav1.Duration{Duration: 5 * time.Minute},
+					ScaleDownCooldown: &metav1.Duration{Duration: 30 * time.Minute},
+				},
+			},
+		}
+
+		for rackID := 1; rackID <= test.racks; rackID++ {
+			aeroCluster.Spec.RackConfig.Racks = append(aeroCluster.Spec.RackConfig.Racks, asdbv1.Rack{ID: rackID})
+		}
+
+		if test.lastScaleAgo != 0 {
+			aeroCluster.Status.Autoscaling = &asdbv1.AutoscalingStatus{
+				LastScaleTime: &metav1.Time{Time: time.Now().Add(-test.lastScaleAgo)},
+			}
+		}
+
+		r := &SingleClusterReconciler{aeroCluster: aeroCluster}
+
+		size, decision := r.getAutoscalingSize(test.usagePct)
+		if size != test.wantSize {
+			t.Errorf("%s: expected size %d, got %d", test.name, test.wantSize, size)
+		}
+
+		if !strings.Contains(decision, test.wantDecision) {
+			t.Errorf("%s: expected decision containing %q, got %q", test.name, test.wantDecision, decision)
+		}
+	}
+}
+
+func TestGetAutoscalingUsagePct(t *testing.T) {
+	namespaces := []asdbv1.NamespaceHealthStatus{
+		{Name: "test", MemoryUsedPct: ptr.To[int32](30), DeviceUsedPct: ptr.To[int32](50)},
+		{Name: "bar", MemoryUsedPct: ptr.To[int32](60), IndexUsedPct: ptr.To[int32](20)},
+	}
+
+	tests := []struct {
+		name       string
+		metrics    []asdbv1.AutoscalingMetric
+		namespaces []asdbv1.NamespaceHealthStatus
+		wantPct    int32
+		wantFound  bool
+	}{
+		{
+			name: "highest usage across the metrics and namespaces",
+			metrics: []asdbv1.AutoscalingMetric{
+				asdbv1.AutoscalingMetricMemory, asdbv1.AutoscalingMetricDevice, asdbv1.AutoscalingMetricIndex,
+			},
+			namespaces: namespaces,
+			wantPct:    60,
+			wantFound:  true,
+		},
+		{
+			name:       "device usage",
+			metrics:    []asdbv1.AutoscalingMetric{asdbv1.AutoscalingMetricDevice},
+			namespaces: namespaces,
+			wantPct:    50,
+			wantFound:  true,
+		},
+		{
+			name:       "index usage",
+			metrics:    []asdbv1.AutoscalingMetric{asdbv1.AutoscalingMetricIndex},
+			namespaces: namespaces,
+			wantPct:    20,
+			wantFound:  true,
+		},
+		{
+			name:    "no usage reported for the metric",
+			metrics: []asdbv1.AutoscalingMetric{asdbv1.AutoscalingMetricIndex},
+			namespaces: []asdbv1.NamespaceHealthStatus{
+				{Name: "test", MemoryUsedPct: ptr.To[int32](30)},
+			},
+		},
+		{
+			name:    "no namespaces",
+			metrics: []asdbv1.AutoscalingMetric{asdbv1.AutoscalingMetricMemory},
+		},
+	}
+
+	for _, test := range tests {
+		usagePct, found := getAutoscalingUsagePct(test.metrics, test.namespaces)
+		if usagePct != test.wantPct || found != test.wantFound {
+			t.Errorf(
+				"%s: expected usage %d%% found %v, got %d%% found %v", test.name, test.wantPct, test.wantFound,
+				usagePct, found,
+			)
+		}
+	}
+}
@@ -0,0 +1,475 @@
+package cluster
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+	"github.com/aerospike/aerospike-management-lib/deployment"
+)
+
+// canaryRefreshPeriod is the period in seconds at which the health gates and the approval of the canary upgrade
+// are checked.
+const canaryRefreshPeriod = 10
+
+// reconcileCanaryUpgrade upgrades the canary pods before the remaining pods when the image is changed.
+// The remaining pods are upgraded by the racks reconcile only after the canary pods have soaked, passed the
+// health gates and the upgrade is approved, if required. Returns success once the canary stage is completed.
+func (r *SingleClusterReconciler) reconcileCanaryUpgrade(
+	rackStateList []RackState, ignorablePodNames sets.Set[string],
+) common.ReconcileResult {
+	canaryStatus := r.aeroCluster.Status.Canary
+
+	if r.getCanaryUpgradeSpec() == nil || r.IsStatusEmpty() || r.aeroCluster.Status.Image == r.aeroCluster.Spec.Image {
+		// Remove the canary status if canary is disabled or the image change is reverted.
+		if canaryStatus != nil && (r.getCanaryUpgradeSpec() == nil || canaryStatus.Image != r.aeroCluster.Spec.Image) {
+			if err := r.setCanaryStatus(nil); err != nil {
+				return common.ReconcileError(err)
+			}
+		}
+
+		return common.ReconcileSuccess()
+	}
+
+	if canaryStatus == nil || canaryStatus.Image != r.aeroCluster.Spec.Image {
+		canaryPods, err := r.getCanaryPods(rackStateList, ignorablePodNames)
+		if err != nil {
+			return common.ReconcileError(err)
+		}
+
+		canaryStatus = &asdbv1.CanaryStatus{
+			Image: r.aeroCluster.Spec.Image,
+			Stage: asdbv1.CanaryStageUpgrading,
+			Pods:  getPodNames(canaryPods),
+		}
+
+		if err := r.setCanaryStatus(canaryStatus); err != nil {
+			return common.ReconcileError(err)
+		}
+
+		r.Log.Info("Starting canary upgrade", "image", canaryStatus.Image, "pods", canaryStatus.Pods)
+		r.Recorder.Eventf(
+			r.aeroCluster, corev1.EventTypeNormal, "CanaryUpgradeStarted",
+			"Upgrading canary pods %v to image %s", canaryStatus.Pods, canaryStatus.Image,
+		)
+	}
+
+	switch canaryStatus.Stage {
+	case asdbv1.CanaryStageUpgrading:
+		return r.upgradeCanaryPods(rackStateList, canaryStatus, ignorablePodNames)
+
+	case asdbv1.CanaryStageSoaking:
+		return r.soakCanaryPods(canaryStatus)
+
+	case asdbv1.CanaryStageVerifying:
+		return r.verifyCanaryPods(canaryStatus, ignorablePodNames)
+
+	case asdbv1.CanaryStageAwaitingApproval:
+		return r.checkCanaryApproval(canaryStatus)
+
+	case asdbv1.CanaryStageCompleted:
+	}
+
+	return common.ReconcileSuccess()
+}
+
+// upgradeCanaryPods upgrades one batch of the canary pods of a rack in each reconcile.
+// The soak starts once all the canary pods are upgraded.
+func (r *SingleClusterReconciler) upgradeCanaryPods(
+	rackStateList []RackState, canaryStatus *asdbv1.CanaryStatus, ignorablePodNames sets.Set[string],
+) common.ReconcileResult {
+	canaryPodNames := sets.New(canaryStatus.Pods...)
+
+	allowed, err := r.isDisruptionAllowed()
+	if err != nil {
+		return common.ReconcileError(err)
+	}
+
+	var deferred bool
+
+	for idx := range rackStateList {
+		rackState := &rackStateList[idx]
+
+		podList, lErr := r.getOrderedRackPodList(rackState.Rack.ID)
+		if lErr != nil {
+			return common.ReconcileError(fmt.Errorf("failed to list pods: %v", lErr))
+		}
+
+		var podsToUpgrade []*corev1.Pod
+
+		for _, pod := range podList {
+			if canaryPodNames.Has(pod.Name) && !ignorablePodNames.Has(pod.Name) && !r.isPodUpgraded(pod) {
+				podsToUpgrade = append(podsToUpgrade, pod)
+			}
+		}
+
+		if len(podsToUpgrade) == 0 {
+			continue
+		}
+
+		if !allowed {
+			podNames := make([]string, 0, len(podsToUpgrade))
+			for _, pod := range podsToUpgrade {
+				podNames = append(podNames, pod.Name)
+			}
+
+			r.deferDisruptiveWork(
+				asdbv1.DisruptiveWork{Type: asdbv1.DisruptiveWorkPodRestart, RackID: rackState.Rack.ID, Pods: podNames},
+			)
+
+			deferred = true
+
+			continue
+		}
+
+		return r.upgradeRackCanaryPods(rackState, podsToUpgrade, len(podList), ignorablePodNames)
+	}
+
+	// The remaining pods are not upgraded either as the canary stage is not completed.
+	if deferred {
+		return common.ReconcileSuccess()
+	}
+
+	now := metav1.Now()
+	canaryStatus.Stage = asdbv1.CanaryStageSoaking
+	canaryStatus.SoakStartTime = &now
+	canaryStatus.Message = ""
+
+	if err = r.setCanaryStatus(canaryStatus); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "CanaryPodsUpgraded",
+		"Upgraded canary pods %v to image %s", canaryStatus.Pods, canaryStatus.Image,
+	)
+
+	return r.soakCanaryPods(canaryStatus)
+}
+
+// upgradeRackCanaryPods upgrades a batch of the given canary pods of the rack.
+func (r *SingleClusterReconciler) upgradeRackCanaryPods(
+	rackState *RackState, podsToUpgrade []*corev1.Pod, rackSize int, ignorablePodNames sets.Set[string],
+) common.ReconcileResult {
+	if err := r.updateSTSConfigMap(
+		utils.GetNamespacedNameForSTSOrConfigMap(r.aeroCluster, rackState.Rack.ID), rackState.Rack,
+	); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	statefulSet, err := r.getSTS(rackState)
+	if err != nil {
+		return common.ReconcileError(err)
+	}
+
+	// Update strategy for statefulSet is OnDelete, so only the deleted canary pods come up with the new image.
+	if err = r.updateSTS(statefulSet, rackState); err != nil {
+		return common.ReconcileError(fmt.Errorf("upgrade canary pods: %v", err))
+	}
+
+	restartTypeMap := make(map[string]RestartType, len(podsToUpgrade))
+	for _, pod := range podsToUpgrade {
+		restartTypeMap[pod.Name] = podRestart
+	}
+
+	if err = r.updateRackStatus(rackState, restartTypeMap); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	if res := r.waitForRollingUpdateBatch(ignorablePodNames); !res.IsSuccess {
+		return res
+	}
+
+	podsBatch := getPodsBatchList(
+		r.aeroCluster.Spec.RackConfig.RollingUpdateBatchSize, podsToUpgrade, rackSize,
+	)[0]
+	podNames := getPodNames(podsBatch)
+
+	r.Log.Info("Upgrading canary pods", "rackID", rackState.Rack.ID, "podsBatch", podNames)
+
+	if err = r.createOrUpdatePodServiceIfNeeded(podNames); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "PodImageUpdate",
+		"[rack-%d] Updating Containers on canary Pods %v", rackState.Rack.ID, podNames,
+	)
+
+	if res := r.safelyDeletePodsAndEnsureImageUpdated(rackState, podsBatch, ignorablePodNames); !res.IsSuccess {
+		return res
+	}
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "PodImageUpdated",
+		"[rack-%d] Updated Containers on canary Pods %v", rackState.Rack.ID, podNames,
+	)
+
+	// Handle the next batch in subsequent Reconcile.
+	return common.ReconcileRequeueAfter(1)
+}
+
+// soakCanaryPods waits for the soak duration to elapse before verifying the health gates.
+func (r *SingleClusterReconciler) soakCanaryPods(canaryStatus *asdbv1.CanaryStatus) common.ReconcileResult {
+	soakDuration := r.getCanaryUpgradeSpec().SoakDuration.Duration
+
+	if canaryStatus.SoakStartTime != nil {
+		if remaining := time.Until(canaryStatus.SoakStartTime.Add(soakDuration)); remaining > 0 {
+			r.Log.Info("Waiting for canary pods to soak", "remaining", remaining.String())
+
+			return common.ReconcileRequeueAfter(int(math.Ceil(remaining.Seconds())))
+		}
+	}
+
+	canaryStatus.Stage = asdbv1.CanaryStageVerifying
+
+	if err := r.setCanaryStatus(canaryStatus); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	return common.ReconcileRequeueAfter(1)
+}
+
+// verifyCanaryPods checks the health gates, they are checked again periodically until they pass.
+func (r *SingleClusterReconciler) verifyCanaryPods(
+	canaryStatus *asdbv1.CanaryStatus, ignorablePodNames sets.Set[string],
+) common.ReconcileResult {
+	if err := r.checkCanaryHealthGates(canaryStatus, ignorablePodNames); err != nil {
+		r.Log.Info("Canary health gates not passed yet", "reason", err.Error())
+
+		if canaryStatus.Message != err.Error() {
+			canaryStatus.Message = err.Error()
+
+			if sErr := r.setCanaryStatus(canaryStatus); sErr != nil {
+				return common.ReconcileError(sErr)
+			}
+		}
+
+		return common.ReconcileRequeueAfter(canaryRefreshPeriod)
+	}
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "CanaryHealthGatesPassed",
+		"Canary pods %v passed the health gates on image %s", canaryStatus.Pods, canaryStatus.Image,
+	)
+
+	canaryStatus.Stage = asdbv1.CanaryStageAwaitingApproval
+	canaryStatus.Message = ""
+
+	if !r.getCanaryUpgradeSpec().RequireApproval {
+		canaryStatus.Stage = asdbv1.CanaryStageCompleted
+	}
+
+	if err := r.setCanaryStatus(canaryStatus); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	return r.checkCanaryApproval(canaryStatus)
+}
+
+// checkCanaryApproval completes the canary stage once the upgrade to the canary image is approved.
+func (r *SingleClusterReconciler) checkCanaryApproval(canaryStatus *asdbv1.CanaryStatus) common.ReconcileResult {
+	if canaryStatus.Stage == asdbv1.CanaryStageAwaitingApproval {
+		if r.aeroCluster.Annotations[asdbv1.CanaryApprovalAnnotation] != canaryStatus.Image {
+			message := fmt.Sprintf(
+				"Waiting for annotation %s=%s to upgrade the remaining pods", asdbv1.CanaryApprovalAnnotation,
+				canaryStatus.Image,
+			)
+
+			if canaryStatus.Message != message {
+				canaryStatus.Message = message
+
+				if err := r.setCanaryStatus(canaryStatus); err != nil {
+					return common.ReconcileError(err)
+				}
+			}
+
+			r.Log.Info("Waiting for canary upgrade approval", "image", canaryStatus.Image)
+
+			return common.ReconcileRequeueAfter(canaryRefreshPeriod)
+		}
+
+		canaryStatus.Stage = asdbv1.CanaryStageCompleted
+		canaryStatus.Message = ""
+
+		if err := r.setCanaryStatus(canaryStatus); err != nil {
+			return common.ReconcileError(err)
+		}
+	}
+
+	r.Log.Info("Canary upgrade completed, upgrading the remaining pods", "image", canaryStatus.Image)
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "CanaryUpgradeCompleted",
+		"Canary upgrade to image %s completed, upgrading the remaining pods", canaryStatus.Image,
+	)
+
+	return common.ReconcileSuccess()
+}
+
+// checkCanaryHealthGates returns an error describing the first health gate not passed by the cluster.
+func (r *SingleClusterReconciler) checkCanaryHealthGates(
+	canaryStatus *asdbv1.CanaryStatus, ignorablePodNames sets.Set[string],
+) error {
+	podList, err := r.getClusterPodList()
+	if err != nil {
+		return err
+	}
+
+	canaryPodNames := sets.New(canaryStatus.Pods...)
+
+	var canaryPods []*corev1.Pod
+
+	for idx := range podList.Items {
+		pod := &podList.Items[idx]
+		if ignorablePodNames.Has(pod.Name) {
+			continue
+		}
+
+		if !utils.IsPodRunningAndReady(pod) {
+			return fmt.Errorf("pod %s is not ready", pod.Name)
+		}
+
+		if canaryPodNames.Has(pod.Name) {
+			canaryPods = append(canaryPods, pod)
+		}
+	}
+
+	serverHealth, err := r.getServerHealth()
+	if err != nil {
+		return err
+	}
+
+	if !serverHealth.ClusterKeyAgreement {
+		return fmt.Errorf("cluster is not stable, nodes do not agree on the cluster key and size")
+	}
+
+	remaining := serverHealth.MigrateTxPartitionsRemaining + serverHealth.MigrateRxPartitionsRemaining
+	if remaining > 0 {
+		return fmt.Errorf("migrations are not complete, %d partitions remaining", remaining)
+	}
+
+	for idx := range serverHealth.Namespaces {
+		nsHealth := &serverHealth.Namespaces[idx]
+		if nsHealth.DeadPartitions > 0 || nsHealth.UnavailablePartitions > 0 {
+			return fmt.Errorf(
+				"namespace %s has %d dead and %d unavailable partitions", nsHealth.Name, nsHealth.DeadPartitions,
+				nsHealth.UnavailablePartitions,
+			)
+		}
+	}
+
+	return r.runInfoChecks(r.getCanaryUpgradeSpec().InfoChecks, canaryPods)
+}
+
+// runInfoChecks runs the info command checks on the given pods and returns an error for the first failed check.
+func (r *SingleClusterReconciler) runInfoChecks(checks []asdbv1.InfoCommandCheck, pods []*corev1.Pod) error {
+	if len(checks) == 0 {
+		return nil
+	}
+
+	policy := r.getClientPolicy()
+
+	for _, pod := range pods {
+		asConn := r.newAsConn(pod)
+
+		for idx := range checks {
+			check := &checks[idx]
+
+			res, err := asConn.RunInfo(policy, check.Command)
+			if err != nil {
+				return fmt.Errorf("info check %s on pod %s failed: %v", check.Command, pod.Name, err)
+			}
+
+			value := res[check.Command]
+
+			if check.Key != "" {
+				stats, pErr := deployment.ParseInfoIntoMap(value, ";", "=")
+				if pErr != nil {
+					return fmt.Errorf("info check %s on pod %s failed: %v", check.Command, pod.Name, pErr)
+				}
+
+				value = stats[check.Key]
+			}
+
+			if value != check.Value {
+				return fmt.Errorf(
+					"info check %s %s on pod %s failed: expected %q, found %q", check.Command, check.Key, pod.Name,
+					check.Value, value,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getCanaryPods returns the pods to be upgraded first. These are the pods of the canary rack if given, otherwise
+// the given number of pods picked from the racks in rackConfig order.
+func (r *SingleClusterReconciler) getCanaryPods(
+	rackStateList []RackState, ignorablePodNames sets.Set[string],
+) ([]*corev1.Pod, error) {
+	canarySpec := r.getCanaryUpgradeSpec()
+
+	count := 1
+
+	if canarySpec.Pods != nil {
+		scaledCount, err := intstr.GetScaledValueFromIntOrPercent(canarySpec.Pods, int(r.aeroCluster.Spec.Size), true)
+		if err != nil {
+			return nil, err
+		}
+
+		count = max(scaledCount, 1)
+	}
+
+	var canaryPods []*corev1.Pod
+
+	for idx := range rackStateList {
+		rackID := rackStateList[idx].Rack.ID
+		if canarySpec.RackID != nil && *canarySpec.RackID != rackID {
+			continue
+		}
+
+		podList, err := r.getOrderedRackPodList(rackID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods: %v", err)
+		}
+
+		for _, pod := range podList {
+			if canarySpec.RackID == nil && len(canaryPods) == count {
+				return canaryPods, nil
+			}
+
+			if !ignorablePodNames.Has(pod.Name) {
+				canaryPods = append(canaryPods, pod)
+			}
+		}
+	}
+
+	return canaryPods, nil
+}
+
+func (r *SingleClusterReconciler) getCanaryUpgradeSpec() *asdbv1.CanaryUpgradeSpec {
+	if r.aeroCluster.Spec.UpgradeStrategy == nil {
+		return nil
+	}
+
+	return r.aeroCluster.Spec.UpgradeStrategy.Canary
+}
+
+func (r *SingleClusterReconciler) setCanaryStatus(canaryStatus *asdbv1.CanaryStatus) error {
+	if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.Canary = canaryStatus
+	}); err != nil {
+		return fmt.Errorf("failed to update canary status: %v", err)
+	}
+
+	return nil
+}
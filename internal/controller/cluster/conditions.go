@@ -0,0 +1,159 @@
+package cluster
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+// newCondition returns a condition observed for the current generation of the AerospikeCluster.
+func (r *SingleClusterReconciler) newCondition(
+	condType string, status metav1.ConditionStatus, reason, message string,
+) metav1.Condition {
+	return metav1.Condition{
+		Type:               condType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: r.aeroCluster.Generation,
+	}
+}
+
+// isConditionUpdateNeeded returns true if any of the given conditions differs from the one in the current status.
+func (r *SingleClusterReconciler) isConditionUpdateNeeded(conditions []metav1.Condition) bool {
+	for idx := range conditions {
+		existing := meta.FindStatusCondition(r.aeroCluster.Status.Conditions, conditions[idx].Type)
+		if existing == nil || existing.Status != conditions[idx].Status ||
+			existing.Reason != conditions[idx].Reason || existing.Message != conditions[idx].Message ||
+			existing.ObservedGeneration != conditions[idx].ObservedGeneration {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setStatusConditions sets the given conditions in the AerospikeCluster status.
+// LastTransitionTime of a condition is changed only if its status changes.
+func (r *SingleClusterReconciler) setStatusConditions(conditions ...metav1.Condition) error {
+	if !r.isConditionUpdateNeeded(conditions) {
+		return nil
+	}
+
+	if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		for idx := range conditions {
+			meta.SetStatusCondition(&status.Conditions, conditions[idx])
+		}
+	}); err != nil {
+		r.Log.Error(err, "Failed to set status conditions")
+		return err
+	}
+
+	return nil
+}
+
+// getAvailableCondition returns the Available condition from the readiness of the cluster pods.
+func (r *SingleClusterReconciler) getAvailableCondition() (metav1.Condition, error) {
+	podList, err := r.getClusterPodList()
+	if err != nil {
+		return metav1.Condition{}, err
+	}
+
+	var notReadyPods []string
+
+	for idx := range podList.Items {
+		if !utils.IsPodRunningAndReady(&podList.Items[idx]) {
+			notReadyPods = append(notReadyPods, podList.Items[idx].Name)
+		}
+	}
+
+	if len(notReadyPods) != 0 {
+		sort.Strings(notReadyPods)
+
+		return r.newCondition(
+			asdbv1.ConditionTypeAvailable, metav1.ConditionFalse, asdbv1.ConditionReasonPodsNotReady,
+			fmt.Sprintf("Pods are not running and ready: %s", strings.Join(notReadyPods, ",")),
+		), nil
+	}
+
+	if len(podList.Items) != int(r.aeroCluster.Spec.Size) {
+		return r.newCondition(
+			asdbv1.ConditionTypeAvailable, metav1.ConditionFalse, asdbv1.ConditionReasonPodsNotReady,
+			fmt.Sprintf("%d of %d pods are running and ready", len(podList.Items), r.aeroCluster.Spec.Size),
+		), nil
+	}
+
+	return r.newCondition(
+		asdbv1.ConditionTypeAvailable, metav1.ConditionTrue, asdbv1.ConditionReasonReconcileSucceeded,
+		"All pods are running and ready",
+	), nil
+}
+
+// getConfigDriftCondition returns the ConfigDriftDetected condition by comparing the desired aerospikeConfig of
+// each rack with the aerospikeConfig last applied to the cluster.
+func (r *SingleClusterReconciler) getConfigDriftCondition() metav1.Condition {
+	if r.IsStatusEmpty() {
+		return r.newCondition(
+			asdbv1.ConditionTypeConfigDriftDetected, metav1.ConditionFalse, asdbv1.ConditionReasonConfigInSync,
+			"Cluster is being created",
+		)
+	}
+
+	statusRacks := make(map[int]*asdbv1.Rack, len(r.aeroCluster.Status.RackConfig.Racks))
+	for idx := range r.aeroCluster.Status.RackConfig.Racks {
+		statusRacks[r.aeroCluster.Status.RackConfig.Racks[idx].ID] = &r.aeroCluster.Status.RackConfig.Racks[idx]
+	}
+
+	var driftedRacks []string
+
+	for idx := range r.aeroCluster.Spec.RackConfig.Racks {
+		specRack := &r.aeroCluster.Spec.RackConfig.Racks[idx]
+
+		statusRack, ok := statusRacks[specRack.ID]
+		if !ok {
+			// Newly added rack, nothing has been applied yet.
+			continue
+		}
+
+		if !reflect.DeepEqual(specRack.AerospikeConfig.Value, statusRack.AerospikeConfig.Value) {
+			driftedRacks = append(driftedRacks, fmt.Sprintf("%d", specRack.ID))
+		}
+	}
+
+	if len(driftedRacks) == 0 {
+		return r.newCondition(
+			asdbv1.ConditionTypeConfigDriftDetected, metav1.ConditionFalse, asdbv1.ConditionReasonConfigInSync,
+			"Desired aerospikeConfig is applied to all racks",
+		)
+	}
+
+	sort.Strings(driftedRacks)
+
+	return r.newCondition(
+		asdbv1.ConditionTypeConfigDriftDetected, metav1.ConditionTrue, asdbv1.ConditionReasonConfigUpdatePending,
+		fmt.Sprintf("Desired aerospikeConfig is not yet applied to racks: %s", strings.Join(driftedRacks, ",")),
+	)
+}
+
+// getACLReconciledCondition returns the ACLReconciled condition after access control is reconciled successfully.
+func (r *SingleClusterReconciler) getACLReconciledCondition() metav1.Condition {
+	enabled, err := asdbv1.IsSecurityEnabled(r.aeroCluster.Spec.AerospikeConfig)
+	if err == nil && !enabled {
+		return r.newCondition(
+			asdbv1.ConditionTypeACLReconciled, metav1.ConditionTrue, asdbv1.ConditionReasonSecurityDisabled,
+			"Security is not enabled, no access control to reconcile",
+		)
+	}
+
+	return r.newCondition(
+		asdbv1.ConditionTypeACLReconciled, metav1.ConditionTrue, asdbv1.ConditionReasonACLReconciled,
+		"Access control spec is applied to the cluster",
+	)
+}
@@ -0,0 +1,94 @@
+package cluster
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+func newTestPod(name string, phase corev1.PodPhase, ready bool) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testClusterNamespace,
+			Labels:    utils.LabelsForAerospikeClusterRack(testClusterName, 0),
+		},
+		Status: corev1.PodStatus{
+			Phase:             phase,
+			ContainerStatuses: []corev1.ContainerStatus{{Name: asdbv1.AerospikeServerContainerName, Ready: ready}},
+		},
+	}
+}
+
+func TestGetAvailableCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		size       int32
+		pods       []client.Object
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name: "all pods running and ready",
+			size: 2,
+			pods: []client.Object{
+				newTestPod(testClusterName+"-0-0", corev1.PodRunning, true),
+				newTestPod(testClusterName+"-0-1", corev1.PodRunning, true),
+			},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: asdbv1.ConditionReasonReconcileSucceeded,
+		},
+		{
+			name: "pod not ready",
+			size: 2,
+			pods: []client.Object{
+				newTestPod(testClusterName+"-0-0", corev1.PodRunning, true),
+				newTestPod(testClusterName+"-0-1", corev1.PodRunning, false),
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: asdbv1.ConditionReasonPodsNotReady,
+		},
+		{
+			name: "pod pending",
+			size: 2,
+			pods: []client.Object{
+				newTestPod(testClusterName+"-0-0", corev1.PodRunning, true),
+				newTestPod(testClusterName+"-0-1", corev1.PodPending, false),
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: asdbv1.ConditionReasonPodsNotReady,
+		},
+		{
+			name: "pod missing",
+			size: 2,
+			pods: []client.Object{
+				newTestPod(testClusterName+"-0-0", corev1.PodRunning, true),
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: asdbv1.ConditionReasonPodsNotReady,
+		},
+	}
+
+	for _, test := range tests {
+		r := newTestReconciler(test.pods...)
+		r.aeroCluster.Spec.Size = test.size
+
+		condition, err := r.getAvailableCondition()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		if condition.Type != asdbv1.ConditionTypeAvailable || condition.Status != test.wantStatus ||
+			condition.Reason != test.wantReason {
+			t.Errorf(
+				"%s: expected Available %s with reason %s, got %+v", test.name, test.wantStatus, test.wantReason,
+				condition,
+			)
+		}
+	}
+}
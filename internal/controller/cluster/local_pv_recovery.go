@@ -0,0 +1,178 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+// recoverPodsOnLostLocalPVs deletes the local PVCs and the pods of the racks with storage.localPVRecovery enabled,
+// which are unschedulable for at least the grace period because their local volumes are on a missing or NotReady
+// node. The StatefulSet recreates the pods on another node with new local volumes, which are initialized again.
+// It requeues the reconcile if a pod is still in its grace period.
+func (r *SingleClusterReconciler) recoverPodsOnLostLocalPVs(rackStateList []RackState) common.ReconcileResult {
+	var requeueAfter time.Duration
+
+	for idx := range rackStateList {
+		rackState := &rackStateList[idx]
+
+		recovery := rackState.Rack.Storage.LocalPVRecovery
+		if recovery == nil || !recovery.Enabled {
+			continue
+		}
+
+		podList, err := r.getRackPodList(rackState.Rack.ID)
+		if err != nil {
+			return common.ReconcileError(fmt.Errorf("failed to list pods: %v", err))
+		}
+
+		for podIdx := range podList.Items {
+			pod := &podList.Items[podIdx]
+
+			// Pods are unschedulable with a volume node affinity conflict if no node matches their local volumes.
+			isPodUnschedulable, reason := utils.IsPodReasonUnschedulable(pod)
+			if !isPodUnschedulable || !strings.Contains(reason, utils.VolumeNodeAffinityConflict) ||
+				utils.IsPodTerminating(pod) {
+				continue
+			}
+
+			lostNode, err := r.getLostLocalPVNode(rackState, pod)
+			if err != nil {
+				return common.ReconcileError(err)
+			}
+
+			if lostNode == "" {
+				continue
+			}
+
+			since := utils.GetPodUnschedulableSince(pod)
+			if remaining := time.Until(since.Add(recovery.GracePeriod.Duration)); remaining > 0 {
+				r.Log.Info(
+					"Pod is unschedulable on the local volumes of a lost node, waiting for the grace period",
+					"podName", pod.Name, "node", lostNode, "remaining", remaining,
+				)
+
+				if requeueAfter == 0 || remaining < requeueAfter {
+					requeueAfter = remaining
+				}
+
+				continue
+			}
+
+			r.Log.Info(
+				"Pod is unschedulable on the local volumes of a lost node, deleting its local PVCs and the pod",
+				"podName", pod.Name, "node", lostNode,
+			)
+
+			if err := r.deleteLocalPVCs(rackState, pod); err != nil {
+				return common.ReconcileError(err)
+			}
+
+			if err := r.Client.Delete(context.TODO(), pod); err != nil && !errors.IsNotFound(err) {
+				return common.ReconcileError(fmt.Errorf("failed to delete pod %s: %v", pod.Name, err))
+			}
+
+			r.Recorder.Eventf(
+				r.aeroCluster, corev1.EventTypeWarning, "LocalPVRecovered",
+				"[rack-%d] Deleted Pod %s and its local PVCs of lost node %s", rackState.Rack.ID, pod.Name, lostNode,
+			)
+		}
+	}
+
+	if requeueAfter > 0 {
+		return common.ReconcileRequeueAfter(int(requeueAfter.Seconds()) + 1)
+	}
+
+	return common.ReconcileSuccess()
+}
+
+// getLostLocalPVNode returns the node of a local volume of the pod, if that node is missing or NotReady.
+// It returns an empty string if all the local volumes of the pod are on available nodes.
+func (r *SingleClusterReconciler) getLostLocalPVNode(rackState *RackState, pod *corev1.Pod) (string, error) {
+	pvcItems, err := r.getPodsPVCList([]string{pod.Name}, rackState.Rack.ID)
+	if err != nil {
+		return "", fmt.Errorf("could not find pvc for pod %v: %v", pod.Name, err)
+	}
+
+	for idx := range pvcItems {
+		pvc := &pvcItems[idx]
+		if pvc.Spec.StorageClassName == nil || pvc.Spec.VolumeName == "" ||
+			!utils.ContainsString(rackState.Rack.Storage.LocalStorageClasses, *pvc.Spec.StorageClassName) {
+			continue
+		}
+
+		pv := &corev1.PersistentVolume{}
+		if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: pvc.Spec.VolumeName}, pv); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+
+			return "", fmt.Errorf("failed to get pv %s of pvc %s: %v", pvc.Spec.VolumeName, pvc.Name, err)
+		}
+
+		for _, hostname := range getPVHostnames(pv) {
+			isLost, err := r.isNodeLost(hostname)
+			if err != nil {
+				return "", err
+			}
+
+			if isLost {
+				return hostname, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// getPVHostnames returns the hostnames which the node affinity of a local pv is restricted to.
+func getPVHostnames(pv *corev1.PersistentVolume) []string {
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return nil
+	}
+
+	var hostnames []string
+
+	for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		for _, expr := range term.MatchExpressions {
+			if expr.Key == corev1.LabelHostname && expr.Operator == corev1.NodeSelectorOpIn {
+				hostnames = append(hostnames, expr.Values...)
+			}
+		}
+	}
+
+	return hostnames
+}
+
+// isNodeLost returns true if there is no node with the hostname, or if the node is NotReady.
+func (r *SingleClusterReconciler) isNodeLost(hostname string) (bool, error) {
+	nodeList := &corev1.NodeList{}
+	if err := r.Client.List(
+		context.TODO(), nodeList, client.MatchingLabels{corev1.LabelHostname: hostname},
+	); err != nil {
+		return false, fmt.Errorf("failed to list nodes with hostname %s: %v", hostname, err)
+	}
+
+	if len(nodeList.Items) == 0 {
+		return true, nil
+	}
+
+	for idx := range nodeList.Items {
+		for _, condition := range nodeList.Items[idx].Status.Conditions {
+			if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
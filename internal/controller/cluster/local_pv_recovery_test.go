@@ -0,0 +1,295 @@
+package cluster
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+const (
+	testClusterName      = "aerocluster"
+	testClusterNamespace = "aerospike"
+	testLocalClass       = "local"
+	testNetworkClass     = "ssd"
+)
+
+func newTestReconciler(objs ...client.Object) *SingleClusterReconciler {
+	return &SingleClusterReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(objs...).Build(),
+		Recorder: record.NewFakeRecorder(10),
+		aeroCluster: &asdbv1.AerospikeCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: testClusterName, Namespace: testClusterNamespace},
+		},
+		Log: logr.Discard(),
+	}
+}
+
+func newTestNode(hostname string, ready corev1.ConditionStatus) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: hostname, Labels: map[string]string{corev1.LabelHostname: hostname}},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+		},
+	}
+}
+
+func newTestPV(name string, hostnames ...string) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1.PersistentVolumeSpec{
+			NodeAffinity: &corev1.VolumeNodeAffinity{
+				Required: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{
+							MatchExpressions: []corev1.NodeSelectorRequirement{
+								{Key: corev1.LabelHostname, Operator: corev1.NodeSelectorOpIn, Values: hostnames},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTestPVC(name, storageClass, volumeName string, rackID int) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testClusterNamespace,
+			Labels:    utils.LabelsForAerospikeClusterRack(testClusterName, rackID),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: &storageClass,
+			VolumeName:       volumeName,
+		},
+	}
+}
+
+func newTestUnschedulablePod(name string, rackID int, since time.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testClusterNamespace,
+			Labels:    utils.LabelsForAerospikeClusterRack(testClusterName, rackID),
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:               corev1.PodScheduled,
+					Status:             corev1.ConditionFalse,
+					Reason:             corev1.PodReasonUnschedulable,
+					Message:            "0/3 nodes are available: 3 node(s) had volume node affinity conflict.",
+					LastTransitionTime: metav1.NewTime(since),
+				},
+			},
+		},
+	}
+}
+
+func TestGetPVHostnames(t *testing.T) {
+	tests := []struct {
+		name string
+		pv   *corev1.PersistentVolume
+		want []string
+	}{
+		{
+			name: "no node affinity",
+			pv:   &corev1.PersistentVolume{},
+		},
+		{
+			name: "hostname",
+			pv:   newTestPV("pv", "node-1"),
+			want: []string{"node-1"},
+		},
+		{
+			name: "multiple terms",
+			pv: &corev1.PersistentVolume{
+				Spec: corev1.PersistentVolumeSpec{
+					NodeAffinity: &corev1.VolumeNodeAffinity{
+						Required: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{
+								{
+									MatchExpressions: []corev1.NodeSelectorRequirement{
+										{
+											Key:      corev1.LabelHostname,
+											Operator: corev1.NodeSelectorOpIn,
+											Values:   []string{"node-1"},
+										},
+										{
+											Key:      corev1.LabelTopologyZone,
+											Operator: corev1.NodeSelectorOpIn,
+											Values:   []string{"zone-1"},
+										},
+									},
+								},
+								{
+									MatchExpressions: []corev1.NodeSelectorRequirement{
+										{
+											Key:      corev1.LabelHostname,
+											Operator: corev1.NodeSelectorOpNotIn,
+											Values:   []string{"node-2"},
+										},
+										{
+											Key:      corev1.LabelHostname,
+											Operator: corev1.NodeSelectorOpIn,
+											Values:   []string{"node-3"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{"node-1", "node-3"},
+		},
+	}
+
+	for _, test := range tests {
+		if got := getPVHostnames(test.pv); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected hostnames %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestIsNodeLost(t *testing.T) {
+	r := newTestReconciler(
+		newTestNode("ready-node", corev1.ConditionTrue),
+		newTestNode("not-ready-node", corev1.ConditionFalse),
+		newTestNode("unknown-node", corev1.ConditionUnknown),
+	)
+
+	tests := []struct {
+		hostname string
+		want     bool
+	}{
+		{"ready-node", false},
+		{"not-ready-node", true},
+		{"unknown-node", true},
+		{"missing-node", true},
+	}
+
+	for _, test := range tests {
+		got, err := r.isNodeLost(test.hostname)
+		if err != nil {
+			t.Fatalf("node %s: unexpected error: %v", test.hostname, err)
+		}
+
+		if got != test.want {
+			t.Errorf("node %s: expected lost %v, got %v", test.hostname, test.want, got)
+		}
+	}
+}
+
+func TestRecoverPodsOnLostLocalPVs(t *testing.T) {
+	const rackID = 1
+
+	now := time.Now()
+	recoveredPod := newTestUnschedulablePod(testClusterName+"-1-0", rackID, now.Add(-time.Hour))
+	waitingPod := newTestUnschedulablePod(testClusterName+"-1-1", rackID, now)
+	readyNodePod := newTestUnschedulablePod(testClusterName+"-1-2", rackID, now.Add(-time.Hour))
+
+	r := newTestReconciler(
+		newTestNode("ready-node", corev1.ConditionTrue),
+		newTestPV("pv-0", "lost-node"),
+		newTestPV("pv-1", "lost-node"),
+		newTestPV("pv-2", "ready-node"),
+		recoveredPod, waitingPod, readyNodePod,
+		newTestPVC("ns-"+recoveredPod.Name, testLocalClass, "pv-0", rackID),
+		newTestPVC("workdir-"+recoveredPod.Name, testNetworkClass, "", rackID),
+		newTestPVC("ns-"+waitingPod.Name, testLocalClass, "pv-1", rackID),
+		newTestPVC("ns-"+readyNodePod.Name, testLocalClass, "pv-2", rackID),
+	)
+
+	rackState := RackState{
+		Rack: &asdbv1.Rack{
+			ID: rackID,
+			Storage: asdbv1.AerospikeStorageSpec{
+				LocalStorageClasses: []string{testLocalClass},
+				LocalPVRecovery: &asdbv1.LocalPVRecoverySpec{
+					Enabled:     true,
+					GracePeriod: &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+		},
+		Size: 3,
+	}
+
+	res := r.recoverPodsOnLostLocalPVs([]RackState{rackState})
+	if res.Err != nil {
+		t.Fatalf("unexpected error: %v", res.Err)
+	}
+
+	if res.IsSuccess || res.Result.RequeueAfter <= 0 || res.Result.RequeueAfter > 10*time.Minute+time.Second {
+		t.Errorf("expected requeue within the grace period of pod %s, got %+v", waitingPod.Name, res)
+	}
+
+	tests := []struct {
+		obj     client.Object
+		name    string
+		deleted bool
+	}{
+		{&corev1.Pod{}, recoveredPod.Name, true},
+		{&corev1.PersistentVolumeClaim{}, "ns-" + recoveredPod.Name, true},
+		{&corev1.PersistentVolumeClaim{}, "workdir-" + recoveredPod.Name, false},
+		{&corev1.Pod{}, waitingPod.Name, false},
+		{&corev1.PersistentVolumeClaim{}, "ns-" + waitingPod.Name, false},
+		{&corev1.Pod{}, readyNodePod.Name, false},
+		{&corev1.PersistentVolumeClaim{}, "ns-" + readyNodePod.Name, false},
+	}
+
+	for _, test := range tests {
+		err := r.Client.Get(
+			context.TODO(), types.NamespacedName{Name: test.name, Namespace: testClusterNamespace}, test.obj,
+		)
+		if deleted := errors.IsNotFound(err); deleted != test.deleted {
+			t.Errorf("%T %s: expected deleted %v, got error %v", test.obj, test.name, test.deleted, err)
+		}
+	}
+}
+
+func TestRecoverPodsOnLostLocalPVsDisabled(t *testing.T) {
+	const rackID = 1
+
+	pod := newTestUnschedulablePod(testClusterName+"-1-0", rackID, time.Now().Add(-time.Hour))
+
+	r := newTestReconciler(
+		newTestPV("pv-0", "lost-node"),
+		pod,
+		newTestPVC("ns-"+pod.Name, testLocalClass, "pv-0", rackID),
+	)
+
+	rackState := RackState{
+		Rack: &asdbv1.Rack{
+			ID: rackID,
+			Storage: asdbv1.AerospikeStorageSpec{
+				LocalStorageClasses: []string{testLocalClass},
+			},
+		},
+		Size: 1,
+	}
+
+	if res := r.recoverPodsOnLostLocalPVs([]RackState{rackState}); !res.IsSuccess {
+		t.Fatalf("expected success, got %+v", res)
+	}
+
+	if err := r.Client.Get(context.TODO(), client.ObjectKeyFromObject(pod), &corev1.Pod{}); err != nil {
+		t.Errorf("expected pod %s to be kept, got error %v", pod.Name, err)
+	}
+}
@@ -0,0 +1,143 @@
+package cluster
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+)
+
+// isDisruptionAllowed returns true if the disruptive changes can be applied now, i.e. no maintenance window is
+// configured or a maintenance window is open.
+func (r *SingleClusterReconciler) isDisruptionAllowed() (bool, error) {
+	if len(r.aeroCluster.Spec.MaintenanceWindows) == 0 {
+		return true, nil
+	}
+
+	now := time.Now()
+
+	for idx := range r.aeroCluster.Spec.MaintenanceWindows {
+		open, err := r.aeroCluster.Spec.MaintenanceWindows[idx].IsOpen(now)
+		if err != nil {
+			return false, fmt.Errorf("failed to check maintenance window: %v", err)
+		}
+
+		if open {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// deferPodRestarts defers the restart of the rack pods until the next maintenance window.
+func (r *SingleClusterReconciler) deferPodRestarts(rackState *RackState, restartTypeMap map[string]RestartType) {
+	podNames := make([]string, 0, len(restartTypeMap))
+
+	for podName, restartType := range restartTypeMap {
+		if restartType == podRestart || restartType == quickRestart {
+			podNames = append(podNames, podName)
+		}
+	}
+
+	sort.Strings(podNames)
+
+	r.deferDisruptiveWork(
+		asdbv1.DisruptiveWork{
+			Type:   asdbv1.DisruptiveWorkPodRestart,
+			RackID: rackState.Rack.ID,
+			Pods:   podNames,
+		},
+	)
+}
+
+func (r *SingleClusterReconciler) deferDisruptiveWork(work asdbv1.DisruptiveWork) {
+	r.Log.Info(
+		"Deferring disruptive work until the next maintenance window", "type", work.Type, "rackID", work.RackID,
+		"pods", work.Pods,
+	)
+
+	r.deferredWork = append(r.deferredWork, work)
+}
+
+// reconcileMaintenanceWindowStatus publishes the disruptive work deferred in this reconcile along with the start of
+// the next maintenance window. The reconcile is requeued for the next window if any work is deferred.
+func (r *SingleClusterReconciler) reconcileMaintenanceWindowStatus() common.ReconcileResult {
+	if len(r.aeroCluster.Spec.MaintenanceWindows) == 0 {
+		if r.aeroCluster.Status.MaintenanceWindow != nil {
+			if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+				status.MaintenanceWindow = nil
+			}); err != nil {
+				return common.ReconcileError(fmt.Errorf("failed to update maintenance window status: %v", err))
+			}
+		}
+
+		return common.ReconcileSuccess()
+	}
+
+	nextStart, err := r.getNextMaintenanceWindowStart()
+	if err != nil {
+		return common.ReconcileError(err)
+	}
+
+	windowStatus := &asdbv1.MaintenanceWindowStatus{
+		PendingWork: r.deferredWork,
+	}
+
+	if !nextStart.IsZero() {
+		windowStatus.NextWindowStart = &metav1.Time{Time: nextStart}
+	}
+
+	if err = r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.MaintenanceWindow = windowStatus
+	}); err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to update maintenance window status: %v", err))
+	}
+
+	if len(r.deferredWork) == 0 {
+		return common.ReconcileSuccess()
+	}
+
+	msg := fmt.Sprintf(
+		"%d disruptive changes are waiting for the next maintenance window at %s", len(r.deferredWork),
+		nextStart.Format(time.RFC3339),
+	)
+
+	if err = r.setStatusConditions(
+		r.newCondition(
+			asdbv1.ConditionTypeProgressing, metav1.ConditionTrue, asdbv1.ConditionReasonMaintenanceWindow, msg,
+		),
+	); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	r.Recorder.Event(r.aeroCluster, corev1.EventTypeNormal, "DisruptiveWorkDeferred", msg)
+
+	return common.ReconcileRequeueAfter(max(int(math.Ceil(time.Until(nextStart).Seconds())), 1))
+}
+
+// getNextMaintenanceWindowStart returns the earliest start of the maintenance windows.
+func (r *SingleClusterReconciler) getNextMaintenanceWindowStart() (time.Time, error) {
+	var nextStart time.Time
+
+	now := time.Now()
+
+	for idx := range r.aeroCluster.Spec.MaintenanceWindows {
+		start, err := r.aeroCluster.Spec.MaintenanceWindows[idx].NextStart(now)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to get next maintenance window: %v", err)
+		}
+
+		if !start.IsZero() && (nextStart.IsZero() || start.Before(nextStart)) {
+			nextStart = start
+		}
+	}
+
+	return nextStart, nil
+}
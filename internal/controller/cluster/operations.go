@@ -0,0 +1,574 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	as "github.com/aerospike/aerospike-client-go/v7"
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+	"github.com/aerospike/aerospike-management-lib/deployment"
+)
+
+const infoCmdQuiesceUndo = "quiesce-undo:"
+
+// maxOperationHistory is the maximum number of operations kept in status.operationHistory.
+// Operations which are not completed yet are never dropped.
+const maxOperationHistory = 20
+
+// getOperationPods returns the pods of the given operation.
+// If no pod list is provided, it indicates all the pods.
+func getOperationPods(op *asdbv1.OperationSpec, allPodNames sets.Set[string]) sets.Set[string] {
+	if len(op.PodList) == 0 {
+		return allPodNames
+	}
+
+	return sets.New(op.PodList...)
+}
+
+// isRestartOperation returns true if the operation is executed by restarting its pods.
+func isRestartOperation(op *asdbv1.OperationSpec) bool {
+	return op.Kind == asdbv1.OperationWarmRestart || op.Kind == asdbv1.OperationPodRestart
+}
+
+// getOperationByID returns the operation with the given ID from the list, nil if not found.
+func getOperationByID(ops []asdbv1.OperationSpec, id string) *asdbv1.OperationSpec {
+	for idx := range ops {
+		if ops[idx].ID == id {
+			return &ops[idx]
+		}
+	}
+
+	return nil
+}
+
+// isOperationCompleted returns true if the operation has been performed on all its pods.
+// The pods on which an operation has been performed are tracked in status.operations.
+func isOperationCompleted(
+	specOp *asdbv1.OperationSpec, statusOps []asdbv1.OperationSpec, allPodNames sets.Set[string],
+) bool {
+	statusOp := getOperationByID(statusOps, specOp.ID)
+	if statusOp == nil {
+		return false
+	}
+
+	return getOperationPods(statusOp, allPodNames).IsSuperset(getOperationPods(specOp, allPodNames))
+}
+
+// getCurrentOperationIndex returns the index of the first operation in the queue which is not completed yet.
+// Returns -1 if all the operations are completed.
+func getCurrentOperationIndex(
+	specOps, statusOps []asdbv1.OperationSpec, allPodNames sets.Set[string],
+) int {
+	for idx := range specOps {
+		if !isOperationCompleted(&specOps[idx], statusOps, allPodNames) {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// getCurrentOperation returns the operation being executed. Operations are executed one at a time in the order
+// given in the spec. Returns nil if there is no operation to execute.
+func (r *SingleClusterReconciler) getCurrentOperation() *asdbv1.OperationSpec {
+	idx := getCurrentOperationIndex(
+		r.aeroCluster.Spec.Operations, r.aeroCluster.Status.Operations,
+		asdbv1.GetAllPodNames(r.aeroCluster.Status.Pods),
+	)
+	if idx < 0 {
+		return nil
+	}
+
+	return &r.aeroCluster.Spec.Operations[idx]
+}
+
+// reconcileOperationQueue moves the operation queue forward once all the racks are reconciled.
+// startOp is the operation that was being executed when the racks reconcile started. All its pods have been
+// handled by the racks reconcile, so it is marked completed even if some pods were skipped (e.g. ignorable pods).
+// Operations that don't restart pods are executed here.
+// The reconcile is requeued if any operation is left in the queue.
+func (r *SingleClusterReconciler) reconcileOperationQueue(
+	startOp *asdbv1.OperationSpec, allHostConns []*deployment.HostConn, ignorablePodNames sets.Set[string],
+) common.ReconcileResult {
+	specOps := r.aeroCluster.Spec.Operations
+	if len(specOps) == 0 {
+		return common.ReconcileSuccess()
+	}
+
+	// Get the latest status, operation progress is updated in status while pods are restarted.
+	latestAeroCluster := &asdbv1.AerospikeCluster{}
+	if err := r.Client.Get(context.TODO(), utils.GetNamespacedName(r.aeroCluster), latestAeroCluster); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	allPodNames := asdbv1.GetAllPodNames(latestAeroCluster.Status.Pods)
+
+	idx := getCurrentOperationIndex(specOps, latestAeroCluster.Status.Operations, allPodNames)
+	if idx < 0 {
+		return common.ReconcileSuccess()
+	}
+
+	currentOp := &specOps[idx]
+
+	if !isRestartOperation(currentOp) {
+		result, err := r.executeOperation(currentOp, allHostConns, ignorablePodNames)
+		if err != nil {
+			r.Recorder.Eventf(
+				r.aeroCluster, corev1.EventTypeWarning, "OperationFailed",
+				"Failed to execute on-demand operation %s of kind %s: %v", currentOp.ID, currentOp.Kind, err,
+			)
+
+			return common.ReconcileError(fmt.Errorf("failed to execute operation %s: %v", currentOp.ID, err))
+		}
+
+		if err := r.completeOperation(currentOp, result); err != nil {
+			return common.ReconcileError(fmt.Errorf("failed to complete operation %s: %v", currentOp.ID, err))
+		}
+
+		if idx == len(specOps)-1 {
+			return common.ReconcileSuccess()
+		}
+	} else if startOp != nil && startOp.ID == currentOp.ID {
+		// Last operation in the queue is marked completed along with the rest of the spec in status.
+		if idx == len(specOps)-1 {
+			return common.ReconcileSuccess()
+		}
+
+		if err := r.completeOperation(currentOp, ""); err != nil {
+			return common.ReconcileError(fmt.Errorf("failed to complete operation %s: %v", currentOp.ID, err))
+		}
+	}
+
+	r.Log.Info("Requeue to execute the next on-demand operation")
+
+	return common.ReconcileRequeueAfter(1)
+}
+
+// executeOperation executes an operation which doesn't restart pods.
+// Returns the result of the operation to be reported in status, if any.
+func (r *SingleClusterReconciler) executeOperation(
+	op *asdbv1.OperationSpec, allHostConns []*deployment.HostConn, ignorablePodNames sets.Set[string],
+) (string, error) {
+	policy := r.getClientPolicy()
+
+	var result string
+
+	switch op.Kind {
+	case asdbv1.OperationRecluster:
+		if err := deployment.InfoRecluster(r.Log, policy, allHostConns); err != nil {
+			return "", err
+		}
+
+	case asdbv1.OperationQuiesce:
+		pods, err := r.getPodsByName(getOperationPods(op, asdbv1.GetAllPodNames(r.aeroCluster.Status.Pods)))
+		if err != nil {
+			return "", err
+		}
+
+		if err = r.quiescePods(policy, allHostConns, pods, ignorablePodNames); err != nil {
+			return "", err
+		}
+
+	case asdbv1.OperationTruncate:
+		truncateSpec, err := r.getTruncateSpec(op)
+		if err != nil {
+			return "", err
+		}
+
+		res, err := r.truncate(policy, allHostConns, truncateSpec)
+		if err != nil {
+			return "", err
+		}
+
+		result = res
+
+	case asdbv1.OperationWarmRestart, asdbv1.OperationPodRestart:
+		return "", fmt.Errorf("operation %s of kind %s is executed by restarting pods", op.ID, op.Kind)
+	}
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "OperationExecuted",
+		"Executed on-demand operation %s of kind %s", op.ID, op.Kind,
+	)
+
+	return result, nil
+}
+
+// getTruncateSpec returns the truncate details of the operation along with the cutoff to truncate at.
+// If lastUpdateTime is not given, the current time is recorded in status.operationHistory before the truncate is
+// sent, and reused if the operation is retried. So a retry does not delete the records written after the first try.
+func (r *SingleClusterReconciler) getTruncateSpec(op *asdbv1.OperationSpec) (*asdbv1.TruncateSpec, error) {
+	if op.Truncate == nil {
+		return nil, fmt.Errorf("truncate details are not given")
+	}
+
+	if op.Truncate.LastUpdateTime != nil {
+		return op.Truncate, nil
+	}
+
+	// Time is stored in status with a precision of seconds, the same cutoff is sent on every try.
+	now := metav1.Now().Rfc3339Copy()
+
+	if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.OperationHistory = getOperationHistory(
+			r.aeroCluster.Spec.Operations, status.Operations, status.OperationHistory,
+			asdbv1.GetAllPodNames(status.Pods), nil,
+		)
+
+		if opStatus := getLatestOperationStatus(status.OperationHistory, op.ID); opStatus != nil &&
+			opStatus.TruncateLastUpdateTime == nil {
+			opStatus.TruncateLastUpdateTime = &now
+		}
+	}); err != nil {
+		return nil, fmt.Errorf("failed to record truncate lastUpdateTime in status: %v", err)
+	}
+
+	opStatus := getLatestOperationStatus(r.aeroCluster.Status.OperationHistory, op.ID)
+	if opStatus == nil || opStatus.TruncateLastUpdateTime == nil {
+		return nil, fmt.Errorf("truncate lastUpdateTime of operation %s is not recorded in status", op.ID)
+	}
+
+	truncateSpec := op.Truncate.DeepCopy()
+	truncateSpec.LastUpdateTime = opStatus.TruncateLastUpdateTime.DeepCopy()
+
+	return truncateSpec, nil
+}
+
+// truncate truncates the given namespace or set. Truncate is distributed to the whole cluster by the server,
+// so the info command is run on the first node that responds.
+func (r *SingleClusterReconciler) truncate(
+	policy *as.ClientPolicy, allHostConns []*deployment.HostConn, truncateSpec *asdbv1.TruncateSpec,
+) (string, error) {
+	cmd := getTruncateCmd(truncateSpec)
+
+	var errs []string
+
+	for _, hostConn := range allHostConns {
+		res, err := hostConn.ASConn.RunInfo(policy, cmd)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("node %s: %v", hostConn.ID, err))
+			continue
+		}
+
+		if !strings.EqualFold(res[cmd], "ok") {
+			return "", fmt.Errorf("running %s failed on node %s: %s", cmd, hostConn.ID, res[cmd])
+		}
+
+		r.Log.Info("Truncated aerospike data", "command", cmd, "node", hostConn.ID)
+
+		return fmt.Sprintf("%s: %s", cmd, res[cmd]), nil
+	}
+
+	return "", fmt.Errorf("failed to run %s: %s", cmd, strings.Join(errs, ", "))
+}
+
+// getTruncateCmd returns the info command to truncate the given namespace or set.
+// The last update time cutoff is given to the server in nanoseconds since the Unix epoch.
+func getTruncateCmd(truncateSpec *asdbv1.TruncateSpec) string {
+	cmd := "truncate-namespace:namespace=" + truncateSpec.Namespace
+	if truncateSpec.Set != "" {
+		cmd = fmt.Sprintf("truncate:namespace=%s;set=%s", truncateSpec.Namespace, truncateSpec.Set)
+	}
+
+	if truncateSpec.LastUpdateTime != nil {
+		cmd += fmt.Sprintf(";lut=%d", truncateSpec.LastUpdateTime.UnixNano())
+	}
+
+	return cmd
+}
+
+// getPodsByName returns the cluster pods with the given names.
+func (r *SingleClusterReconciler) getPodsByName(podNames sets.Set[string]) ([]*corev1.Pod, error) {
+	podList, err := r.getClusterPodList()
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make([]*corev1.Pod, 0, podNames.Len())
+
+	for idx := range podList.Items {
+		if podNames.Has(podList.Items[idx].Name) {
+			pods = append(pods, &podList.Items[idx])
+		}
+	}
+
+	return pods, nil
+}
+
+// getQuiesceOperationPods returns the pods of the completed Quiesce operations in the spec, all the pods for an
+// operation without pod list. These pods are kept quiesced until the operation is removed from the spec.
+func (r *SingleClusterReconciler) getQuiesceOperationPods() sets.Set[string] {
+	quiescePods := sets.New[string]()
+	allPodNames := asdbv1.GetAllPodNames(r.aeroCluster.Status.Pods)
+
+	for idx := range r.aeroCluster.Spec.Operations {
+		specOp := &r.aeroCluster.Spec.Operations[idx]
+
+		if specOp.Kind == asdbv1.OperationQuiesce &&
+			isOperationCompleted(specOp, r.aeroCluster.Status.Operations, allPodNames) {
+			quiescePods = quiescePods.Union(getOperationPods(specOp, allPodNames))
+		}
+	}
+
+	return quiescePods
+}
+
+// reconcileQuiescedPods undoes the quiesce left from previous steps, except on the pods of the Quiesce operations
+// in the spec. Those pods are quiesced again if they have been restarted in between.
+func (r *SingleClusterReconciler) reconcileQuiescedPods(
+	policy *as.ClientPolicy, allHostConns []*deployment.HostConn, ignorablePodNames sets.Set[string],
+) error {
+	quiescePodNames := r.getQuiesceOperationPods()
+	if quiescePodNames.Len() == 0 {
+		return deployment.InfoQuiesceUndo(r.Log, policy, allHostConns)
+	}
+
+	quiescePods, err := r.getPodsByName(quiescePodNames)
+	if err != nil {
+		return err
+	}
+
+	quiescedHostIDs, err := deployment.GetQuiescedNodes(r.Log, policy, allHostConns)
+	if err != nil {
+		return err
+	}
+
+	quiescedHosts := sets.New(quiescedHostIDs...)
+	quiesceHosts := sets.New[string]()
+
+	var podsToQuiesce []*corev1.Pod
+
+	for _, pod := range quiescePods {
+		if !utils.IsPodRunningAndReady(pod) {
+			continue
+		}
+
+		asConn := r.newAsConn(pod)
+		host := hostID(asConn.AerospikeHostName, asConn.AerospikePort)
+		quiesceHosts.Insert(host)
+
+		if !quiescedHosts.Has(host) {
+			podsToQuiesce = append(podsToQuiesce, pod)
+		}
+	}
+
+	undoHosts := quiescedHosts.Difference(quiesceHosts)
+
+	if undoHosts.Len() != 0 {
+		r.Log.Info("Running quiesce-undo on nodes", "nodes", undoHosts.UnsortedList())
+
+		for _, hostConn := range allHostConns {
+			if !undoHosts.Has(hostConn.ID) {
+				continue
+			}
+
+			res, rErr := hostConn.ASConn.RunInfo(policy, infoCmdQuiesceUndo)
+			if rErr != nil {
+				return rErr
+			}
+
+			if strings.Contains(strings.ToLower(res[infoCmdQuiesceUndo]), "error") {
+				return fmt.Errorf("running quiesce-undo command failed: %v", res[infoCmdQuiesceUndo])
+			}
+		}
+
+		if err = deployment.InfoRecluster(r.Log, policy, allHostConns); err != nil {
+			return err
+		}
+	}
+
+	if len(podsToQuiesce) == 0 {
+		return nil
+	}
+
+	r.Log.Info("Quiescing restarted pods of Quiesce operations", "pods", getPodNames(podsToQuiesce))
+
+	return r.quiescePods(policy, allHostConns, podsToQuiesce, ignorablePodNames)
+}
+
+// completeOperation marks the given operation completed on all its pods in status.
+// result is the outcome of the operation reported in status.operationHistory, if any.
+func (r *SingleClusterReconciler) completeOperation(op *asdbv1.OperationSpec, result string) error {
+	completedOp := op.DeepCopy()
+
+	if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		if statusOp := getOperationByID(status.Operations, op.ID); statusOp != nil {
+			*statusOp = *completedOp
+		} else {
+			status.Operations = append(status.Operations, *completedOp)
+		}
+
+		status.OperationHistory = getOperationHistory(
+			r.aeroCluster.Spec.Operations, status.Operations, status.OperationHistory,
+			asdbv1.GetAllPodNames(status.Pods), nil,
+		)
+
+		if opStatus := getLatestOperationStatus(status.OperationHistory, op.ID); opStatus != nil && result != "" {
+			opStatus.Result = result
+		}
+	}); err != nil {
+		return err
+	}
+
+	r.Log.Info("Completed on-demand operation", "id", op.ID, "kind", op.Kind)
+
+	return nil
+}
+
+// updateOperationHistory updates the execution status of the on-demand operations in status.operationHistory.
+// recErr is the error of the current reconcile, it fails the running operation.
+func (r *SingleClusterReconciler) updateOperationHistory(recErr error) error {
+	// Operations are not executed while the cluster is paused or only planned.
+	if asdbv1.GetBool(r.aeroCluster.Spec.Paused) || r.isPlanOnly() {
+		return nil
+	}
+
+	if len(r.aeroCluster.Spec.Operations) == 0 && !hasActiveOperation(r.aeroCluster.Status.OperationHistory) {
+		return nil
+	}
+
+	return r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.OperationHistory = getOperationHistory(
+			r.aeroCluster.Spec.Operations, status.Operations, status.OperationHistory,
+			asdbv1.GetAllPodNames(status.Pods), recErr,
+		)
+	})
+}
+
+func hasActiveOperation(history []asdbv1.OperationStatus) bool {
+	for idx := range history {
+		if history[idx].Phase == asdbv1.OperationPending || history[idx].Phase == asdbv1.OperationRunning {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getOperationHistory returns the operation history updated with the progress of the operations in the spec.
+// Operations which are not in the spec anymore and were not completed are marked failed.
+func getOperationHistory(
+	specOps, statusOps []asdbv1.OperationSpec, history []asdbv1.OperationStatus, allPodNames sets.Set[string],
+	recErr error,
+) []asdbv1.OperationStatus {
+	now := metav1.Now()
+	newHistory := make([]asdbv1.OperationStatus, len(history), len(history)+len(specOps))
+	for idx := range history {
+		history[idx].DeepCopyInto(&newHistory[idx])
+	}
+
+	currentIdx := getCurrentOperationIndex(specOps, statusOps, allPodNames)
+	specOpIDs := sets.New[string]()
+
+	for idx := range specOps {
+		specOp := &specOps[idx]
+		specOpIDs.Insert(specOp.ID)
+
+		opStatus := getLatestOperationStatus(newHistory, specOp.ID)
+		completed := isOperationCompleted(specOp, statusOps, allPodNames)
+
+		// A completed operation with the same ID is a new submission if it is not completed in status.
+		if opStatus == nil || (opStatus.Phase == asdbv1.OperationSucceeded && !completed) {
+			newHistory = append(newHistory, asdbv1.OperationStatus{
+				ID:    specOp.ID,
+				Kind:  specOp.Kind,
+				Phase: asdbv1.OperationPending,
+			})
+			opStatus = &newHistory[len(newHistory)-1]
+		}
+
+		if statusOp := getOperationByID(statusOps, specOp.ID); statusOp != nil {
+			podsCompleted := getOperationPods(statusOp, allPodNames).Intersection(
+				getOperationPods(specOp, allPodNames),
+			).UnsortedList()
+			sort.Strings(podsCompleted)
+
+			opStatus.PodsCompleted = podsCompleted
+		}
+
+		switch {
+		case completed:
+			if opStatus.Phase != asdbv1.OperationSucceeded {
+				if opStatus.StartTime == nil {
+					opStatus.StartTime = &now
+				}
+
+				opStatus.Phase = asdbv1.OperationSucceeded
+				opStatus.EndTime = &now
+				opStatus.Message = ""
+			}
+
+		case idx == currentIdx:
+			if opStatus.StartTime == nil {
+				opStatus.StartTime = &now
+			}
+
+			if recErr != nil {
+				opStatus.Phase = asdbv1.OperationFailed
+				opStatus.Message = recErr.Error()
+			} else {
+				opStatus.Phase = asdbv1.OperationRunning
+				opStatus.Message = ""
+			}
+
+		default:
+			opStatus.Phase = asdbv1.OperationPending
+		}
+	}
+
+	for idx := range newHistory {
+		opStatus := &newHistory[idx]
+		if specOpIDs.Has(opStatus.ID) {
+			continue
+		}
+
+		if opStatus.Phase != asdbv1.OperationSucceeded && opStatus.EndTime == nil {
+			opStatus.Phase = asdbv1.OperationFailed
+			opStatus.EndTime = &now
+			opStatus.Message = "Operation removed from spec before completion"
+		}
+	}
+
+	return trimOperationHistory(newHistory, specOpIDs)
+}
+
+// getLatestOperationStatus returns the latest entry of the operation with the given ID from the history.
+func getLatestOperationStatus(history []asdbv1.OperationStatus, id string) *asdbv1.OperationStatus {
+	for idx := len(history) - 1; idx >= 0; idx-- {
+		if history[idx].ID == id {
+			return &history[idx]
+		}
+	}
+
+	return nil
+}
+
+// trimOperationHistory drops the oldest finished operations if the history is longer than maxOperationHistory.
+func trimOperationHistory(history []asdbv1.OperationStatus, specOpIDs sets.Set[string]) []asdbv1.OperationStatus {
+	extra := len(history) - maxOperationHistory
+	if extra <= 0 {
+		return history
+	}
+
+	trimmed := make([]asdbv1.OperationStatus, 0, maxOperationHistory)
+
+	for idx := range history {
+		finished := history[idx].EndTime != nil && !specOpIDs.Has(history[idx].ID)
+		if extra > 0 && finished {
+			extra--
+			continue
+		}
+
+		trimmed = append(trimmed, history[idx])
+	}
+
+	return trimmed
+}
@@ -0,0 +1,338 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+	"github.com/aerospike/aerospike-management-lib/asconfig"
+)
+
+// isPlanOnly returns true if the changes in the spec should only be planned and not applied.
+func (r *SingleClusterReconciler) isPlanOnly() bool {
+	return r.aeroCluster.Spec.ReconcilePolicy == asdbv1.ReconcilePolicyPlanOnly
+}
+
+// reconcilePlan computes the changes needed to reach the desired spec and reports them in status.
+// Nothing is changed in the cluster. Failure to compute the plan is recorded in the plan itself.
+func (r *SingleClusterReconciler) reconcilePlan() common.ReconcileResult {
+	plan, err := r.getReconcilePlan()
+	if err != nil {
+		r.Log.Error(err, "Failed to compute reconcile plan")
+
+		plan.Error = err.Error()
+	}
+
+	if uErr := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.Plan = plan
+	}); uErr != nil {
+		return common.ReconcileError(fmt.Errorf("failed to update reconcile plan in status: %v", uErr))
+	}
+
+	if err != nil {
+		return common.ReconcileRequeueAfter(10)
+	}
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "ReconcilePlanned",
+		"Computed reconcile plan for generation %d, %d racks to change", plan.Generation, len(plan.Racks),
+	)
+
+	return common.ReconcileSuccess()
+}
+
+// removeReconcilePlan removes the plan computed in PlanOnly mode, it is stale once the spec is applied.
+func (r *SingleClusterReconciler) removeReconcilePlan() error {
+	if r.aeroCluster.Status.Plan == nil {
+		return nil
+	}
+
+	return r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.Plan = nil
+	})
+}
+
+// getReconcilePlan computes the planned changes of all the configured racks and of the racks to be deleted.
+// It follows the same decisions as reconcileRacks without acting on them.
+// A partial plan is returned along with the error if any.
+func (r *SingleClusterReconciler) getReconcilePlan() (*asdbv1.ReconcilePlan, error) {
+	plan := &asdbv1.ReconcilePlan{
+		PlannedTime: metav1.Now(),
+		Generation:  r.aeroCluster.Generation,
+	}
+
+	rackStateList := getConfiguredRackStateList(r.aeroCluster)
+
+	racksToDelete, err := r.getRacksToDelete(rackStateList)
+	if err != nil {
+		return plan, err
+	}
+
+	ignorablePodNames, err := r.getIgnorablePods(racksToDelete, rackStateList)
+	if err != nil {
+		return plan, err
+	}
+
+	for idx := range rackStateList {
+		rackPlan, rErr := r.getRackPlan(&rackStateList[idx], ignorablePodNames)
+		if rErr != nil {
+			return plan, rErr
+		}
+
+		if rackPlan != nil {
+			plan.Racks = append(plan.Racks, *rackPlan)
+		}
+	}
+
+	for idx := range racksToDelete {
+		rackPlan, rErr := r.getDeletedRackPlan(&racksToDelete[idx])
+		if rErr != nil {
+			return plan, rErr
+		}
+
+		if rackPlan != nil {
+			plan.Racks = append(plan.Racks, *rackPlan)
+		}
+	}
+
+	sort.Slice(
+		plan.Racks, func(i, j int) bool {
+			return plan.Racks[i].ID < plan.Racks[j].ID
+		},
+	)
+
+	return plan, nil
+}
+
+// getRackPlan returns the planned changes of a configured rack. Returns nil if the rack does not change.
+func (r *SingleClusterReconciler) getRackPlan(
+	rackState *RackState, ignorablePodNames sets.Set[string],
+) (*asdbv1.RackPlan, error) {
+	rackPlan := &asdbv1.RackPlan{
+		ID:          rackState.Rack.ID,
+		Action:      asdbv1.RackPlanActionUpdate,
+		DesiredSize: int32(rackState.Size),
+	}
+
+	found := &appsv1.StatefulSet{}
+	stsName := utils.GetNamespacedNameForSTSOrConfigMap(r.aeroCluster, rackState.Rack.ID)
+
+	if err := r.Client.Get(context.TODO(), stsName, found); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+
+		rackPlan.Action = asdbv1.RackPlanActionCreate
+		rackPlan.StatefulSetChanges = []string{fmt.Sprintf("create StatefulSet %s", stsName.Name)}
+
+		return rackPlan, nil
+	}
+
+	rackPlan.CurrentSize = *found.Spec.Replicas
+	rackPlan.StatefulSetChanges = r.getStatefulSetChanges(found, rackPlan.DesiredSize)
+
+	upgradeNeeded, err := r.isRackUpgradeNeeded(rackState.Rack.ID, ignorablePodNames)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		restartTypeMap        map[string]RestartType
+		dynamicConfDiffPerPod map[string]asconfig.DynamicConfigMap
+	)
+
+	if upgradeNeeded {
+		rackPlan.ImageUpgrade = true
+
+		restartTypeMap, err = r.getUpgradeRestartTypeMap(rackState.Rack.ID, ignorablePodNames)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Compare the pods with the configMap that would be written for the desired spec.
+		confData, cErr := r.createConfigMapData(rackState.Rack)
+		if cErr != nil {
+			return nil, fmt.Errorf("failed to build configMap data: %v", cErr)
+		}
+
+		restartTypeMap, dynamicConfDiffPerPod, err = r.getRollingRestartTypeMapForConfMap(
+			rackState, ignorablePodNames, &corev1.ConfigMap{Data: confData},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	podList, err := r.getRackPodList(rackState.Rack.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range podList.Items {
+		podName := podList.Items[idx].Name
+
+		podPlan, pErr := getPodPlan(
+			podName, rackPlan.DesiredSize, restartTypeMap[podName], dynamicConfDiffPerPod[podName],
+		)
+		if pErr != nil {
+			return nil, pErr
+		}
+
+		if podPlan != nil {
+			rackPlan.Pods = append(rackPlan.Pods, *podPlan)
+		}
+	}
+
+	sortPodPlans(rackPlan.Pods)
+
+	if len(rackPlan.StatefulSetChanges) == 0 && len(rackPlan.Pods) == 0 && !rackPlan.ImageUpgrade {
+		return nil, nil
+	}
+
+	return rackPlan, nil
+}
+
+// getDeletedRackPlan returns the planned changes of a rack removed from the spec.
+// Returns nil if the rack StatefulSet is already deleted.
+func (r *SingleClusterReconciler) getDeletedRackPlan(rack *asdbv1.Rack) (*asdbv1.RackPlan, error) {
+	found := &appsv1.StatefulSet{}
+	stsName := utils.GetNamespacedNameForSTSOrConfigMap(r.aeroCluster, rack.ID)
+
+	if err := r.Client.Get(context.TODO(), stsName, found); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	rackPlan := &asdbv1.RackPlan{
+		ID:                 rack.ID,
+		Action:             asdbv1.RackPlanActionDelete,
+		CurrentSize:        *found.Spec.Replicas,
+		StatefulSetChanges: []string{fmt.Sprintf("delete StatefulSet %s", stsName.Name)},
+	}
+
+	podList, err := r.getRackPodList(rack.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range podList.Items {
+		rackPlan.Pods = append(rackPlan.Pods, asdbv1.PodPlan{PodName: podList.Items[idx].Name, Remove: true})
+	}
+
+	sortPodPlans(rackPlan.Pods)
+
+	return rackPlan, nil
+}
+
+// getStatefulSetChanges returns the changes made directly to the rack StatefulSet spec,
+// i.e. the replicas and the container images.
+func (r *SingleClusterReconciler) getStatefulSetChanges(found *appsv1.StatefulSet, desiredSize int32) []string {
+	var changes []string
+
+	if *found.Spec.Replicas != desiredSize {
+		changes = append(changes, fmt.Sprintf("replicas: %d -> %d", *found.Spec.Replicas, desiredSize))
+	}
+
+	containers := make(
+		[]corev1.Container, 0,
+		len(found.Spec.Template.Spec.InitContainers)+len(found.Spec.Template.Spec.Containers),
+	)
+	containers = append(containers, found.Spec.Template.Spec.InitContainers...)
+	containers = append(containers, found.Spec.Template.Spec.Containers...)
+
+	for idx := range containers {
+		desiredImage, err := utils.GetDesiredImage(r.aeroCluster, containers[idx].Name)
+		if err != nil {
+			// Maybe a deleted sidecar. Ignore.
+			continue
+		}
+
+		if !utils.IsImageEqual(containers[idx].Image, desiredImage) {
+			changes = append(
+				changes, fmt.Sprintf(
+					"container %s image: %s -> %s", containers[idx].Name, containers[idx].Image, desiredImage,
+				),
+			)
+		}
+	}
+
+	return changes
+}
+
+// getPodPlan returns the planned changes of a pod. Pods with ordinal beyond the desired size are removed by
+// scale-down before any restart. Returns nil if the pod does not change.
+func getPodPlan(
+	podName string, desiredSize int32, restartType RestartType, dynamicConfDiff asconfig.DynamicConfigMap,
+) (*asdbv1.PodPlan, error) {
+	ordinal, err := getSTSPodOrdinal(podName)
+	if err != nil {
+		return nil, err
+	}
+
+	if *ordinal >= desiredSize {
+		return &asdbv1.PodPlan{PodName: podName, Remove: true}, nil
+	}
+
+	podRestartType, ok := getPodRestartType(restartType)
+	if !ok {
+		return nil, nil
+	}
+
+	podPlan := &asdbv1.PodPlan{
+		PodName:     podName,
+		RestartType: podRestartType,
+	}
+
+	if restartType == noRestartUpdateConf {
+		podPlan.DynamicConfigChanges = getDynamicConfigChanges(dynamicConfDiff)
+	}
+
+	return podPlan, nil
+}
+
+// getDynamicConfigChanges converts the dynamic config diff to the changes reported in the plan.
+func getDynamicConfigChanges(dynamicConfDiff asconfig.DynamicConfigMap) []asdbv1.DynamicConfigChange {
+	changes := make([]asdbv1.DynamicConfigChange, 0, len(dynamicConfDiff))
+
+	for key, operations := range dynamicConfDiff {
+		for operation, value := range operations {
+			changes = append(
+				changes, asdbv1.DynamicConfigChange{
+					Key: key, Operation: string(operation), Value: fmt.Sprintf("%v", value),
+				},
+			)
+		}
+	}
+
+	sort.Slice(
+		changes, func(i, j int) bool {
+			if changes[i].Key != changes[j].Key {
+				return changes[i].Key < changes[j].Key
+			}
+
+			return changes[i].Operation < changes[j].Operation
+		},
+	)
+
+	return changes
+}
+
+func sortPodPlans(podPlans []asdbv1.PodPlan) {
+	sort.Slice(
+		podPlans, func(i, j int) bool {
+			return podPlans[i].PodName < podPlans[j].PodName
+		},
+	)
+}
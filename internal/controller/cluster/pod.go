@@ -65,6 +65,19 @@ func mergeRestartType(current, incoming RestartType) RestartType {
 // Fetching RestartType of all pods, based on the operation being performed.
 func (r *SingleClusterReconciler) getRollingRestartTypeMap(rackState *RackState, ignorablePodNames sets.Set[string]) (
 	restartTypeMap map[string]RestartType, dynamicConfDiffPerPod map[string]asconfig.DynamicConfigMap, err error) {
+	confMap, err := r.getConfigMap(rackState.Rack.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.getRollingRestartTypeMapForConfMap(rackState, ignorablePodNames, confMap)
+}
+
+// getRollingRestartTypeMapForConfMap returns the RestartType of the rack pods by comparing them with the
+// given rack configMap. The configMap need not be the one stored in the cluster.
+func (r *SingleClusterReconciler) getRollingRestartTypeMapForConfMap(
+	rackState *RackState, ignorablePodNames sets.Set[string], confMap *corev1.ConfigMap,
+) (restartTypeMap map[string]RestartType, dynamicConfDiffPerPod map[string]asconfig.DynamicConfigMap, err error) {
 	var addedNSDevices []string
 
 	restartTypeMap = make(map[string]RestartType)
@@ -75,12 +88,6 @@ func (r *SingleClusterReconciler) getRollingRestartTypeMap(rackState *RackState,
 		return nil, nil, fmt.Errorf("failed to list pods: %v", err)
 	}
 
-	confMap, err := r.getConfigMap(rackState.Rack.ID)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	blockedK8sNodes := sets.NewString(r.aeroCluster.Spec.K8sNodeBlockList...)
 	requiredConfHash := confMap.Data[aerospikeConfHashFileName]
 
 	// Fetching all pods requested for on-demand operations.
@@ -91,8 +98,8 @@ func (r *SingleClusterReconciler) getRollingRestartTypeMap(rackState *RackState,
 			continue
 		}
 
-		if blockedK8sNodes.Has(pods[idx].Spec.NodeName) {
-			r.Log.Info("Pod found in blocked nodes list, will be migrated to a different node",
+		if r.isPodOnAvoidedK8sNode(pods[idx]) {
+			r.Log.Info("Pod found on a blocked or scale-down selected node, will be migrated to a different node",
 				"podName", pods[idx].Name)
 
 			restartTypeMap[pods[idx].Name] = podRestart
@@ -363,7 +370,6 @@ func (r *SingleClusterReconciler) restartPods(
 	restartedPods := make([]*corev1.Pod, 0, len(podsToRestart))
 	restartedPodNames := make([]string, 0, len(podsToRestart))
 	restartedASDPodNames := make([]string, 0, len(podsToRestart))
-	blockedK8sNodes := sets.NewString(r.aeroCluster.Spec.K8sNodeBlockList...)
 
 	for idx := range podsToRestart {
 		pod := podsToRestart[idx]
@@ -379,11 +385,11 @@ func (r *SingleClusterReconciler) restartPods(
 
 			restartedASDPodNames = append(restartedASDPodNames, pod.Name)
 		} else if restartType == podRestart {
-			if blockedK8sNodes.Has(pod.Spec.NodeName) {
-				r.Log.Info("Pod found in blocked nodes list, deleting corresponding local PVCs if any",
+			if r.isPodOnAvoidedK8sNode(pod) {
+				r.Log.Info("Pod found on a blocked or scale-down selected node, deleting corresponding PVCs if any",
 					"podName", pod.Name)
 
-				if err := r.deleteLocalPVCs(rackState, pod); err != nil {
+				if err := r.deleteAvoidedK8sNodePodPVCs(rackState, pod); err != nil {
 					return common.ReconcileError(err)
 				}
 			}
@@ -561,15 +567,13 @@ func (r *SingleClusterReconciler) deletePodAndEnsureImageUpdated(
 		return common.ReconcileError(err)
 	}
 
-	blockedK8sNodes := sets.NewString(r.aeroCluster.Spec.K8sNodeBlockList...)
-
 	// Delete pods
 	for _, pod := range podsToUpdate {
-		if blockedK8sNodes.Has(pod.Spec.NodeName) {
-			r.Log.Info("Pod found in blocked nodes list, deleting corresponding local PVCs if any",
+		if r.isPodOnAvoidedK8sNode(pod) {
+			r.Log.Info("Pod found on a blocked or scale-down selected node, deleting corresponding PVCs if any",
 				"podName", pod.Name)
 
-			if err := r.deleteLocalPVCs(rackState, pod); err != nil {
+			if err := r.deleteAvoidedK8sNodePodPVCs(rackState, pod); err != nil {
 				return common.ReconcileError(err)
 			}
 		}
@@ -1508,16 +1512,14 @@ func (r *SingleClusterReconciler) updateOperationStatus(restartedASDPodNames, re
 	quickRestartsSet := sets.New(restartedASDPodNames...)
 	podRestartsSet := sets.New(restartedPodNames...)
 
-	specOp := r.aeroCluster.Spec.Operations[0]
-
-	var specPods sets.Set[string]
+	// Only the progress of the operation being executed is tracked.
+	specOp := r.getCurrentOperation()
+	if specOp == nil || !isRestartOperation(specOp) {
+		return nil
+	}
 
 	// If no pod list is provided, it indicates that all pods need to be restarted.
-	if len(specOp.PodList) == 0 {
-		specPods = allPodNames
-	} else {
-		specPods = sets.New(specOp.PodList...)
-	}
+	specPods := getOperationPods(specOp, allPodNames)
 
 	opFound := false
 
@@ -1610,18 +1612,16 @@ func (r *SingleClusterReconciler) podsToRestart() (quickRestarts, podRestarts se
 		return quickRestarts, podRestarts
 	}
 
-	// Assuming only one operation is present in the spec.
-	specOp := specOps[0]
+	// Operations are executed one after another, only the pods of the operation being executed are restarted.
+	specOp := r.getCurrentOperation()
+	if specOp == nil {
+		return quickRestarts, podRestarts
+	}
+
+	var podsToRestart sets.Set[string]
 
-	var (
-		podsToRestart, specPods sets.Set[string]
-	)
 	// If no pod list is provided, it indicates that all pods need to be restarted.
-	if len(specOp.PodList) == 0 {
-		specPods = allPodNames
-	} else {
-		specPods = sets.New(specOp.PodList...)
-	}
+	specPods := getOperationPods(specOp, allPodNames)
 
 	opFound := false
 
@@ -1657,6 +1657,8 @@ func (r *SingleClusterReconciler) podsToRestart() (quickRestarts, podRestarts se
 			quickRestarts.Insert(podsToRestart.UnsortedList()...)
 		case asdbv1.OperationPodRestart:
 			podRestarts.Insert(podsToRestart.UnsortedList()...)
+		case asdbv1.OperationQuiesce, asdbv1.OperationRecluster, asdbv1.OperationTruncate:
+			// These operations don't restart pods, they are executed in reconcileOperationQueue.
 		}
 	}
 
@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
 	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
 )
 
@@ -41,11 +42,7 @@ func (r *SingleClusterReconciler) removePVCsAsync(
 		// Can we do it async in scaleDown
 
 		// Check for path in pvc annotations. We put path annotation while creating statefulset
-		pvcStorageVolName, ok := pvc.Annotations[storageVolumeAnnotationKey]
-		if !ok {
-			// Try legacy annotation name.
-			pvcStorageVolName, ok = pvc.Annotations[storageVolumeLegacyAnnotationKey]
-		}
+		pvcStorageVolName, ok := utils.GetPVCStorageVolumeName(&pvc)
 
 		if !ok {
 			err := fmt.Errorf(
@@ -189,18 +186,7 @@ func (r *SingleClusterReconciler) waitForPVCTermination(deletedPVCs []corev1.Per
 func (r *SingleClusterReconciler) getClusterPVCList() (
 	[]corev1.PersistentVolumeClaim, error,
 ) {
-	// List the pvc for this aeroCluster's statefulset
-	pvcList := &corev1.PersistentVolumeClaimList{}
-	labelSelector := labels.SelectorFromSet(utils.LabelsForAerospikeCluster(r.aeroCluster.Name))
-	listOps := &client.ListOptions{
-		Namespace: r.aeroCluster.Namespace, LabelSelector: labelSelector,
-	}
-
-	if err := r.Client.List(context.TODO(), pvcList, listOps); err != nil {
-		return nil, err
-	}
-
-	return pvcList.Items, nil
+	return common.GetClusterPVCList(r.Client, r.aeroCluster)
 }
 
 func (r *SingleClusterReconciler) getRackPVCList(rackID int) (
@@ -237,3 +223,8 @@ func getPVCVolumeConfig(
 
 	return nil
 }
+
+// isPVCOfStorageClass indicates if the PVC is of the storage class of its persistent volume.
+func isPVCOfStorageClass(pvc *corev1.PersistentVolumeClaim, volume *asdbv1.VolumeSpec) bool {
+	return pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName == volume.Source.PersistentVolume.StorageClass
+}
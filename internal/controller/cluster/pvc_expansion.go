@@ -0,0 +1,363 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+const (
+	// volumeExpansionRefreshPeriod is the period in seconds at which the resize of the expanded volumes is checked.
+	volumeExpansionRefreshPeriod = 10
+
+	// fileSystemResizeGracePeriod is the time given to the kubelet to resize the file system of an expanded volume
+	// online, before restarting the pod to resize it offline.
+	fileSystemResizeGracePeriod = 2 * time.Minute
+)
+
+// reconcileVolumeExpansion expands the persistent volumes of the rack whose size is increased in the rack storage.
+// The PVCs are resized, the StatefulSet volumeClaimTemplates are updated so that new pods get the new size and the
+// new storage class, and a pod is restarted only if the file system of its volume is not resized online.
+// It returns a requeue result while the PVCs are being resized.
+func (r *SingleClusterReconciler) reconcileVolumeExpansion(
+	found *appsv1.StatefulSet, rackState *RackState, ignorablePodNames sets.Set[string],
+) (*appsv1.StatefulSet, common.ReconcileResult) {
+	if len(rackState.Rack.Storage.GetPVs()) == 0 {
+		return found, common.ReconcileSuccess()
+	}
+
+	expansions, err := r.resizeRackPVCs(found, rackState)
+	if err != nil {
+		return found, common.ReconcileError(err)
+	}
+
+	if err = r.updateVolumeExpansionStatus(found, expansions); err != nil {
+		return found, common.ReconcileError(err)
+	}
+
+	if isVolumeClaimTemplateOutdated(found, rackState) {
+		if found, err = r.recreateSTSForVolumeClaimTemplates(found, rackState); err != nil {
+			return found, common.ReconcileError(err)
+		}
+	}
+
+	if len(expansions) == 0 {
+		return found, common.ReconcileSuccess()
+	}
+
+	restartTypeMap := make(map[string]RestartType)
+
+	for idx := range expansions {
+		expansion := &expansions[idx]
+		if expansion.State == asdbv1.VolumeExpansionFileSystemResizePending &&
+			time.Since(expansion.LastTransitionTime.Time) > fileSystemResizeGracePeriod &&
+			!ignorablePodNames.Has(expansion.PodName) {
+			restartTypeMap[expansion.PodName] = podRestart
+		}
+	}
+
+	if len(restartTypeMap) != 0 {
+		allowed, aErr := r.isDisruptionAllowed()
+		if aErr != nil {
+			return found, common.ReconcileError(aErr)
+		}
+
+		if !allowed {
+			r.deferPodRestarts(rackState, restartTypeMap)
+
+			return found, common.ReconcileRequeueAfter(volumeExpansionRefreshPeriod)
+		}
+
+		r.Log.Info(
+			"Restarting pods to resize the file system of expanded volumes", "rackID", rackState.Rack.ID,
+			"pods", restartTypeMap,
+		)
+
+		var res common.ReconcileResult
+
+		if found, res = r.rollingRestartRack(found, rackState, ignorablePodNames, restartTypeMap, nil); !res.IsSuccess {
+			return found, res
+		}
+	}
+
+	return found, common.ReconcileRequeueAfter(volumeExpansionRefreshPeriod)
+}
+
+// resizeRackPVCs increases the requested size of the rack PVCs to the size of their volume, and returns the resize
+// state of the PVCs which are not yet resized.
+func (r *SingleClusterReconciler) resizeRackPVCs(
+	found *appsv1.StatefulSet, rackState *RackState,
+) ([]asdbv1.VolumeExpansionStatus, error) {
+	pvcItems, err := r.getRackPVCList(rackState.Rack.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find pvc for rack %d: %v", rackState.Rack.ID, err)
+	}
+
+	var expansions []asdbv1.VolumeExpansionStatus
+
+	for idx := range pvcItems {
+		pvc := &pvcItems[idx]
+		if utils.IsPVCTerminating(pvc) {
+			continue
+		}
+
+		pvcStorageVolName, ok := utils.GetPVCStorageVolumeName(pvc)
+		if !ok {
+			continue
+		}
+
+		volume := getPVCVolumeConfig(&rackState.Rack.Storage, pvcStorageVolName)
+		if volume == nil || volume.Source.PersistentVolume == nil || !isPVCOfStorageClass(pvc, volume) {
+			// The pvcs of an old storage class are replaced by the storage class migration.
+			continue
+		}
+
+		size := volume.Source.PersistentVolume.Size
+		requestedSize := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+
+		if size.Cmp(requestedSize) > 0 {
+			if err := r.resizePVC(pvc, size); err != nil {
+				return nil, err
+			}
+
+			r.Recorder.Eventf(
+				r.aeroCluster, corev1.EventTypeNormal, "PVCResize",
+				"[rack-%d] Resizing PVC %s/%s from %s to %s", rackState.Rack.ID, pvc.Namespace, pvc.Name,
+				requestedSize.String(), size.String(),
+			)
+
+			requestedSize = size
+		}
+
+		capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+		if ok && capacity.Cmp(requestedSize) >= 0 {
+			continue
+		}
+
+		expansion := asdbv1.VolumeExpansionStatus{
+			PVCName:       pvc.Name,
+			PodName:       strings.TrimPrefix(pvc.Name, pvcStorageVolName+"-"),
+			RequestedSize: requestedSize,
+			State:         asdbv1.VolumeExpansionResizing,
+		}
+
+		if ok {
+			expansion.Capacity = &capacity
+		}
+
+		for condIdx := range pvc.Status.Conditions {
+			condition := &pvc.Status.Conditions[condIdx]
+			if condition.Type == corev1.PersistentVolumeClaimFileSystemResizePending &&
+				condition.Status == corev1.ConditionTrue {
+				expansion.State = asdbv1.VolumeExpansionFileSystemResizePending
+			}
+		}
+
+		r.Log.Info(
+			"PVC is being resized", "stsName", found.Name, "PVC", pvc.Name, "state", expansion.State,
+			"requestedSize", requestedSize.String(),
+		)
+
+		expansions = append(expansions, expansion)
+	}
+
+	return expansions, nil
+}
+
+func (r *SingleClusterReconciler) resizePVC(pvc *corev1.PersistentVolumeClaim, size resource.Quantity) error {
+	r.Log.Info("Resizing PVC", "PVC", pvc.Name, "size", size.String())
+
+	patch := client.MergeFrom(pvc.DeepCopy())
+
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = size
+
+	if err := r.Client.Patch(context.TODO(), pvc, patch); err != nil {
+		return fmt.Errorf("failed to resize pvc %s: %v", pvc.Name, err)
+	}
+
+	return nil
+}
+
+// updateVolumeExpansionStatus replaces the volume expansions of the rack in the status. The transition time of a
+// PVC is retained as long as its state is unchanged. Expansions of the racks not in the spec are dropped.
+func (r *SingleClusterReconciler) updateVolumeExpansionStatus(
+	found *appsv1.StatefulSet, expansions []asdbv1.VolumeExpansionStatus,
+) error {
+	now := metav1.Now()
+	oldExpansions := r.aeroCluster.Status.VolumeExpansions
+
+	for idx := range expansions {
+		expansions[idx].LastTransitionTime = now
+
+		for oldIdx := range oldExpansions {
+			if oldExpansions[oldIdx].PVCName == expansions[idx].PVCName &&
+				oldExpansions[oldIdx].State == expansions[idx].State {
+				expansions[idx].LastTransitionTime = oldExpansions[oldIdx].LastTransitionTime
+				break
+			}
+		}
+	}
+
+	var newExpansions []asdbv1.VolumeExpansionStatus
+
+	for idx := range oldExpansions {
+		if !isPodOfSTS(oldExpansions[idx].PodName, found.Name) && r.isPodOfSpecRack(oldExpansions[idx].PodName) {
+			newExpansions = append(newExpansions, oldExpansions[idx])
+		}
+	}
+
+	newExpansions = append(newExpansions, expansions...)
+
+	if equality.Semantic.DeepEqual(newExpansions, oldExpansions) {
+		return nil
+	}
+
+	return r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.VolumeExpansions = newExpansions
+	})
+}
+
+// isPodOfSpecRack indicates if the pod belongs to one of the racks in the spec.
+func (r *SingleClusterReconciler) isPodOfSpecRack(podName string) bool {
+	for idx := range r.aeroCluster.Spec.RackConfig.Racks {
+		stsName := utils.GetNamespacedNameForSTSOrConfigMap(r.aeroCluster, r.aeroCluster.Spec.RackConfig.Racks[idx].ID)
+		if isPodOfSTS(podName, stsName.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isPodOfSTS(podName, stsName string) bool {
+	ordinal, found := strings.CutPrefix(podName, stsName+"-")
+
+	return found && ordinal != "" && !strings.Contains(ordinal, "-")
+}
+
+// isVolumeClaimTemplateOutdated indicates if a StatefulSet volumeClaimTemplate of the rack has a different storage
+// class than its volume, or a smaller size.
+func isVolumeClaimTemplateOutdated(found *appsv1.StatefulSet, rackState *RackState) bool {
+	for idx := range found.Spec.VolumeClaimTemplates {
+		claim := found.Spec.VolumeClaimTemplates[idx].DeepCopy()
+		if updateVolumeClaimTemplate(claim, rackState) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// updateVolumeClaimTemplate updates the storage class and the size of the volumeClaimTemplate from its volume in
+// the rack storage. It returns true if the volumeClaimTemplate is updated.
+func updateVolumeClaimTemplate(claim *corev1.PersistentVolumeClaim, rackState *RackState) bool {
+	volume := getPVCVolumeConfig(&rackState.Rack.Storage, claim.Name)
+	if volume == nil || volume.Source.PersistentVolume == nil {
+		return false
+	}
+
+	pv := volume.Source.PersistentVolume
+
+	if claim.Spec.StorageClassName == nil || *claim.Spec.StorageClassName != pv.StorageClass {
+		// The pvcs of the old storage class are replaced by the storage class migration.
+		claim.Spec.StorageClassName = &pv.StorageClass
+		claim.Spec.Resources.Requests[corev1.ResourceStorage] = pv.Size
+
+		return true
+	}
+
+	if pv.Size.Cmp(claim.Spec.Resources.Requests[corev1.ResourceStorage]) > 0 {
+		claim.Spec.Resources.Requests[corev1.ResourceStorage] = pv.Size
+
+		return true
+	}
+
+	return false
+}
+
+// recreateSTSForVolumeClaimTemplates updates the storage class and the size of the StatefulSet
+// volumeClaimTemplates. The volumeClaimTemplates of a StatefulSet cannot be updated, so the StatefulSet is deleted
+// leaving its pods running and is recreated with the new volumeClaimTemplates. The pods are adopted by the new
+// StatefulSet without being restarted, as the StatefulSet uses the OnDelete update strategy.
+// If the operator stops after the deletion, the StatefulSet is created again from the spec by the next reconcile.
+func (r *SingleClusterReconciler) recreateSTSForVolumeClaimTemplates(
+	found *appsv1.StatefulSet, rackState *RackState,
+) (*appsv1.StatefulSet, error) {
+	r.Log.Info("Recreating StatefulSet to update volumeClaimTemplates", "stsName", found.Name)
+
+	newSTS := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            found.Name,
+			Namespace:       found.Namespace,
+			Labels:          found.Labels,
+			Annotations:     found.Annotations,
+			OwnerReferences: found.OwnerReferences,
+		},
+		Spec: *found.Spec.DeepCopy(),
+	}
+
+	for idx := range newSTS.Spec.VolumeClaimTemplates {
+		updateVolumeClaimTemplate(&newSTS.Spec.VolumeClaimTemplates[idx], rackState)
+	}
+
+	if err := r.Client.Delete(
+		context.TODO(), found, client.PropagationPolicy(metav1.DeletePropagationOrphan),
+	); err != nil && !errors.IsNotFound(err) {
+		return found, fmt.Errorf("failed to delete StatefulSet %s: %v", found.Name, err)
+	}
+
+	if err := r.waitForSTSDeletion(rackState); err != nil {
+		return found, err
+	}
+
+	if err := r.Client.Create(context.TODO(), newSTS, common.CreateOption); err != nil {
+		return found, fmt.Errorf("failed to recreate StatefulSet %s: %v", newSTS.Name, err)
+	}
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "STSRecreated",
+		"[rack-%d] Recreated StatefulSet %s/%s to update volumeClaimTemplates", rackState.Rack.ID,
+		newSTS.Namespace, newSTS.Name,
+	)
+
+	return r.getSTS(rackState)
+}
+
+func (r *SingleClusterReconciler) waitForSTSDeletion(rackState *RackState) error {
+	pollAttempts := 30
+	sleepInterval := time.Second * 2
+
+	for i := 0; i < pollAttempts; i++ {
+		if _, err := r.getSTS(rackState); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		r.Log.Info("Waiting for StatefulSet deletion", "rackID", rackState.Rack.ID)
+
+		time.Sleep(sleepInterval)
+	}
+
+	return fmt.Errorf("StatefulSet deletion timed out for rack %d", rackState.Rack.ID)
+}
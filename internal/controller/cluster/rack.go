@@ -57,6 +57,40 @@ func (r *SingleClusterReconciler) reconcileRacks() common.ReconcileResult {
 		ignorablePodNames.UnsortedList(),
 	)
 
+	// Publish racks status before making any change. It is refreshed for each rack as the rack is reconciled.
+	racksStatus, err := r.getRacksStatus(rackStateList, racksToDelete)
+	if err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to get racks status: %v", err))
+	}
+
+	if err = r.setRacksStatus(racksStatus); err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to update racks status: %v", err))
+	}
+
+	// Racks are resized in steps if rackConfig.rebalancePolicy is set.
+	rebalanceStepSizes, err := r.getRackRebalanceStep(rackStateList, racksToDelete, ignorablePodNames)
+	if err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to get rack rebalance step: %v", err))
+	}
+
+	if rebalanceStepSizes != nil {
+		for idx := range rackStateList {
+			rackStateList[idx].Size = rebalanceStepSizes[rackStateList[idx].Rack.ID]
+		}
+	}
+
+	// Roll back the image upgrade before handling the failed pods, if the pods are failing on the new image.
+	if res = r.reconcileUpgradeRollback(ignorablePodNames); !res.IsSuccess {
+		return res
+	}
+
+	// Recover the pods stuck on the local volumes of a lost node before handling the failed pods. The reconcile is
+	// requeued at the end for the pods still in their grace period.
+	localPVRecoveryRes := r.recoverPodsOnLostLocalPVs(rackStateList)
+	if localPVRecoveryRes.Err != nil {
+		return localPVRecoveryRes
+	}
+
 	// Handle failed racks
 	for idx := range rackStateList {
 		var podList []*corev1.Pod
@@ -138,8 +172,20 @@ func (r *SingleClusterReconciler) reconcileRacks() common.ReconcileResult {
 		}
 	}
 
-	for idx := range rackStateList {
-		state := &rackStateList[idx]
+	// Upgrade the canary pods first, the remaining pods are upgraded only after the canary stage is completed.
+	if res = r.reconcileCanaryUpgrade(rackStateList, ignorablePodNames); !res.IsSuccess {
+		return res
+	}
+
+	// Racks are updated one at a time, in the order given by the rolling update strategy.
+	orderedRackStateList := r.getRackStateListInUpdateOrder(rackStateList)
+
+	for idx := range orderedRackStateList {
+		if res = r.waitForRollingUpdatePause(); !res.IsSuccess {
+			return res
+		}
+
+		state := &orderedRackStateList[idx]
 		found := &appsv1.StatefulSet{}
 		stsName := utils.GetNamespacedNameForSTSOrConfigMap(r.aeroCluster, state.Rack.ID)
 
@@ -170,19 +216,42 @@ func (r *SingleClusterReconciler) reconcileRacks() common.ReconcileResult {
 		}
 	}
 
+	// Scale-down and rack deletion remove the pods along with their storage, so they wait for a maintenance window.
+	allowed, err := r.isDisruptionAllowed()
+	if err != nil {
+		return common.ReconcileError(err)
+	}
+
 	// Reconcile scaledDownRacks after all other racks are reconciled
 	for idx := range scaledDownRackList {
+		if res = r.waitForRollingUpdatePause(); !res.IsSuccess {
+			return res
+		}
+
 		state := scaledDownRackList[idx].rackState
 		sts := scaledDownRackList[idx].rackSTS
 
+		// The rack keeps its size until the scale-down is allowed, its other changes are still reconciled.
+		if !allowed {
+			r.deferDisruptiveWork(asdbv1.DisruptiveWork{Type: asdbv1.DisruptiveWorkScaleDown, RackID: state.Rack.ID})
+
+			state = &RackState{Rack: state.Rack, Size: int(*sts.Spec.Replicas)}
+		}
+
 		if res = r.reconcileRack(sts, state, ignorablePodNames, nil); !res.IsSuccess {
 			return res
 		}
 	}
 
-	if len(r.aeroCluster.Status.RackConfig.Racks) != 0 {
+	if !allowed {
+		for idx := range racksToDelete {
+			r.deferDisruptiveWork(
+				asdbv1.DisruptiveWork{Type: asdbv1.DisruptiveWorkRackDeletion, RackID: racksToDelete[idx].ID},
+			)
+		}
+	} else if len(r.aeroCluster.Status.RackConfig.Racks) != 0 {
 		// Remove removed racks
-		if res = r.deleteRacks(racksToDelete, ignorablePodNames); !res.IsSuccess {
+		if res = r.deleteRacks(racksToDelete, rebalanceStepSizes, ignorablePodNames); !res.IsSuccess {
 			if res.Err != nil {
 				r.Log.Error(
 					err, "Failed to remove statefulset for removed racks",
@@ -235,7 +304,11 @@ func (r *SingleClusterReconciler) reconcileRacks() common.ReconcileResult {
 		}
 	}
 
-	return common.ReconcileSuccess()
+	if rebalanceStepSizes != nil {
+		return r.waitForRackRebalance(rebalanceStepSizes)
+	}
+
+	return localPVRecoveryRes
 }
 
 func (r *SingleClusterReconciler) createEmptyRack(rackState *RackState) (
@@ -304,8 +377,10 @@ func (r *SingleClusterReconciler) getRacksToDelete(rackStateList []RackState) (
 	return toDelete, nil
 }
 
+// deleteRacks scales down the removed racks and deletes them. If the racks are rebalanced in steps, the racks are
+// scaled down to their step sizes and deleted only in the last step.
 func (r *SingleClusterReconciler) deleteRacks(
-	racksToDelete []asdbv1.Rack, ignorablePodNames sets.Set[string],
+	racksToDelete []asdbv1.Rack, rebalanceStepSizes map[int]int, ignorablePodNames sets.Set[string],
 ) common.ReconcileResult {
 	for idx := range racksToDelete {
 		rack := &racksToDelete[idx]
@@ -323,13 +398,17 @@ func (r *SingleClusterReconciler) deleteRacks(
 		}
 
 		// TODO: Add option for quick delete of rack. DefaultRackID should always be removed gracefully
-		rackState := &RackState{Size: 0, Rack: rack}
+		rackState := &RackState{Size: rebalanceStepSizes[rack.ID], Rack: rack}
 
 		found, res := r.scaleDownRack(found, rackState, ignorablePodNames)
 		if !res.IsSuccess {
 			return res
 		}
 
+		if *found.Spec.Replicas != 0 {
+			continue
+		}
+
 		// Delete sts
 		if err = r.deleteSTS(found); err != nil {
 			r.Recorder.Eventf(
@@ -401,6 +480,29 @@ func (r *SingleClusterReconciler) upgradeOrRollingRestartRack(
 	}
 
 	if upgradeNeeded {
+		restartTypeMap, uErr := r.getUpgradeRestartTypeMap(rackState.Rack.ID, ignorablePodNames)
+		if uErr != nil {
+			return found, common.ReconcileError(uErr)
+		}
+
+		if uErr = r.updateRackStatus(rackState, restartTypeMap); uErr != nil {
+			return found, common.ReconcileError(uErr)
+		}
+
+		// Failed pods are restarted irrespective of the maintenance windows.
+		if len(failedPods) == 0 {
+			allowed, aErr := r.isDisruptionAllowed()
+			if aErr != nil {
+				return found, common.ReconcileError(aErr)
+			}
+
+			if !allowed {
+				r.deferPodRestarts(rackState, restartTypeMap)
+
+				return found, common.ReconcileSuccess()
+			}
+		}
+
 		found, res = r.upgradeRack(found, rackState, ignorablePodNames, failedPods)
 		if !res.IsSuccess {
 			if res.Err != nil {
@@ -419,13 +521,39 @@ func (r *SingleClusterReconciler) upgradeOrRollingRestartRack(
 
 			return found, res
 		}
+
+		if len(failedPods) == 0 {
+			if uErr = r.pauseAfterRack(rackState); uErr != nil {
+				return found, common.ReconcileError(uErr)
+			}
+		}
 	} else {
 		var rollingRestartInfo, nErr = r.getRollingRestartInfo(rackState, ignorablePodNames)
 		if nErr != nil {
 			return found, common.ReconcileError(nErr)
 		}
 
-		if rollingRestartInfo.needRestart {
+		if nErr = r.updateRackStatus(rackState, rollingRestartInfo.restartTypeMap); nErr != nil {
+			return found, common.ReconcileError(nErr)
+		}
+
+		needRestart := rollingRestartInfo.needRestart
+
+		// Failed pods are restarted irrespective of the maintenance windows.
+		if needRestart && len(failedPods) == 0 {
+			allowed, aErr := r.isDisruptionAllowed()
+			if aErr != nil {
+				return found, common.ReconcileError(aErr)
+			}
+
+			if !allowed {
+				r.deferPodRestarts(rackState, rollingRestartInfo.restartTypeMap)
+
+				needRestart = false
+			}
+		}
+
+		if needRestart {
 			found, res = r.rollingRestartRack(
 				found, rackState, ignorablePodNames, rollingRestartInfo.restartTypeMap, failedPods,
 			)
@@ -446,6 +574,12 @@ func (r *SingleClusterReconciler) upgradeOrRollingRestartRack(
 
 				return found, res
 			}
+
+			if len(failedPods) == 0 {
+				if nErr = r.pauseAfterRack(rackState); nErr != nil {
+					return found, common.ReconcileError(nErr)
+				}
+			}
 		}
 
 		if len(failedPods) == 0 && rollingRestartInfo.needUpdateConf {
@@ -639,6 +773,27 @@ func (r *SingleClusterReconciler) reconcileRack(
 		return common.ReconcileError(err)
 	}
 
+	// Expand volumes before the scale-up, so that new pods are created with the expanded volumes.
+	expansionRes := common.ReconcileSuccess()
+
+	if failedPods == nil {
+		found, expansionRes = r.reconcileVolumeExpansion(found, rackState, ignorablePodNames)
+		if expansionRes.Err != nil {
+			r.Log.Error(expansionRes.Err, "Failed to expand volumes", "stsName", found.Name)
+
+			return expansionRes
+		}
+
+		// The rack is not updated further while its pods are migrated to a new storage class.
+		if res = r.reconcileStorageClassMigration(rackState, ignorablePodNames); !res.IsSuccess {
+			if res.Err != nil {
+				r.Log.Error(res.Err, "Failed to migrate storage class", "stsName", found.Name)
+			}
+
+			return res
+		}
+	}
+
 	found, res = r.upgradeOrRollingRestartRack(found, rackState, ignorablePodNames, failedPods)
 	if !res.IsSuccess {
 		return res
@@ -680,7 +835,8 @@ func (r *SingleClusterReconciler) reconcileRack(
 		}
 	}
 
-	return common.ReconcileSuccess()
+	// Requeue until the expanded volumes are resized.
+	return expansionRes
 }
 
 func (r *SingleClusterReconciler) scaleUpRack(
@@ -844,6 +1000,12 @@ func (r *SingleClusterReconciler) upgradeRack(
 	}
 
 	if len(podsBatchList) > 0 {
+		if len(failedPods) == 0 {
+			if res := r.waitForRollingUpdateBatch(ignorablePodNames); !res.IsSuccess {
+				return statefulSet, res
+			}
+		}
+
 		// Handle one batch
 		podsBatch := podsBatchList[0]
 
@@ -1075,6 +1237,13 @@ func (r *SingleClusterReconciler) scaleDownRack(
 		desiredSize,
 	)
 
+	// The last batch is scaled down
+	if *found.Spec.Replicas <= desiredSize {
+		if err = r.pauseAfterRack(rackState); err != nil {
+			return found, common.ReconcileError(err)
+		}
+	}
+
 	return found, common.ReconcileRequeueAfter(1)
 }
 
@@ -1158,6 +1327,12 @@ func (r *SingleClusterReconciler) rollingRestartRack(
 
 	// Restart batch of pods
 	if len(podsBatchList) > 0 {
+		if len(failedPods) == 0 {
+			if res := r.waitForRollingUpdateBatch(ignorablePodNames); !res.IsSuccess {
+				return found, res
+			}
+		}
+
 		// Handle one batch
 		podsBatch := podsBatchList[0]
 
@@ -1224,8 +1399,6 @@ func (r *SingleClusterReconciler) handleK8sNodeBlockListPods(
 		}
 	}
 
-	blockedK8sNodes := sets.NewString(r.aeroCluster.Spec.K8sNodeBlockList...)
-
 	var podsToRestart []*corev1.Pod
 
 	restartTypeMap := make(map[string]RestartType)
@@ -1233,7 +1406,7 @@ func (r *SingleClusterReconciler) handleK8sNodeBlockListPods(
 	for idx := range podList {
 		pod := podList[idx]
 
-		if blockedK8sNodes.Has(pod.Spec.NodeName) {
+		if r.isPodOnAvoidedK8sNode(pod) {
 			r.Log.Info(
 				"Pod found in blocked nodes list, migrating to a different node",
 				"podName", pod.Name,
@@ -1452,6 +1625,18 @@ func (r *SingleClusterReconciler) isStorageVolumeSourceUpdated(volume *asdbv1.Vo
 		setDefaultsConfigMapVolumeSource(volumeCopy.Source.ConfigMap)
 	}
 
+	if volumeCopy.Source.Projected != nil {
+		setDefaultsProjectedVolumeSource(volumeCopy.Source.Projected)
+	}
+
+	if volumeCopy.Source.Ephemeral != nil {
+		setDefaultsEphemeralVolumeSource(volumeCopy.Source.Ephemeral)
+	}
+
+	if volumeCopy.Source.HostPath != nil {
+		setDefaultsHostPathVolumeSource(volumeCopy.Source.HostPath)
+	}
+
 	if !reflect.DeepEqual(podVolume.Secret, volumeCopy.Source.Secret) {
 		r.Log.Info(
 			"Volume source updated", "old volume.source ",
@@ -1479,6 +1664,18 @@ func (r *SingleClusterReconciler) isStorageVolumeSourceUpdated(volume *asdbv1.Vo
 		return true
 	}
 
+	if !reflect.DeepEqual(podVolume.Projected, volumeCopy.Source.Projected) ||
+		!reflect.DeepEqual(podVolume.CSI, volumeCopy.Source.CSI) ||
+		!reflect.DeepEqual(podVolume.Ephemeral, volumeCopy.Source.Ephemeral) ||
+		!reflect.DeepEqual(podVolume.HostPath, volumeCopy.Source.HostPath) {
+		r.Log.Info(
+			"Volume source updated", "old volume.source ",
+			podVolume.VolumeSource, "new volume.source", volume.Source,
+		)
+
+		return true
+	}
+
 	return false
 }
 
@@ -1889,6 +2086,48 @@ func setDefaultsConfigMapVolumeSource(obj *corev1.ConfigMapVolumeSource) {
 	}
 }
 
+func setDefaultsProjectedVolumeSource(obj *corev1.ProjectedVolumeSource) {
+	if obj.DefaultMode == nil {
+		perm := corev1.ProjectedVolumeSourceDefaultMode
+		obj.DefaultMode = &perm
+	}
+
+	for idx := range obj.Sources {
+		projection := &obj.Sources[idx]
+
+		if projection.ServiceAccountToken != nil && projection.ServiceAccountToken.ExpirationSeconds == nil {
+			// Default token expiration of an hour, set by the API server.
+			expirationSeconds := int64(3600)
+			projection.ServiceAccountToken.ExpirationSeconds = &expirationSeconds
+		}
+
+		if projection.DownwardAPI == nil {
+			continue
+		}
+
+		for itemIdx := range projection.DownwardAPI.Items {
+			fieldRef := projection.DownwardAPI.Items[itemIdx].FieldRef
+			if fieldRef != nil && fieldRef.APIVersion == "" {
+				fieldRef.APIVersion = "v1"
+			}
+		}
+	}
+}
+
+func setDefaultsEphemeralVolumeSource(obj *corev1.EphemeralVolumeSource) {
+	if obj.VolumeClaimTemplate != nil && obj.VolumeClaimTemplate.Spec.VolumeMode == nil {
+		volumeMode := corev1.PersistentVolumeFilesystem
+		obj.VolumeClaimTemplate.Spec.VolumeMode = &volumeMode
+	}
+}
+
+func setDefaultsHostPathVolumeSource(obj *corev1.HostPathVolumeSource) {
+	if obj.Type == nil {
+		hostPathType := corev1.HostPathUnset
+		obj.Type = &hostPathType
+	}
+}
+
 func getContainerVolumeDevice(
 	devices []corev1.VolumeDevice, name string,
 ) *corev1.VolumeDevice {
@@ -0,0 +1,229 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+// rackRebalanceRefreshPeriod is the period in seconds at which the next step of rackConfig.rebalancePolicy is
+// checked.
+const rackRebalanceRefreshPeriod = 10
+
+// getRackRebalanceStep returns the rack sizes of the current step of the gradual rebalancing keyed by rack ID,
+// including the racks to delete. It returns nil if rackConfig.rebalancePolicy is not set or if the racks can be
+// resized to their configured sizes in this step.
+// A new step is taken only when all the pods are ready and the migrations of the previous step are complete,
+// otherwise the racks keep their current sizes.
+// If the deletion of racks is deferred to a maintenance window, the racks to delete keep their current sizes.
+func (r *SingleClusterReconciler) getRackRebalanceStep(
+	rackStateList []RackState, racksToDelete []asdbv1.Rack, ignorablePodNames sets.Set[string],
+) (map[int]int, error) {
+	if r.aeroCluster.Spec.RackConfig.RebalancePolicy == nil {
+		return nil, nil
+	}
+
+	rackIDs := make([]int, 0, len(rackStateList)+len(racksToDelete))
+	targetSizes := make(map[int]int, len(rackStateList)+len(racksToDelete))
+
+	for idx := range rackStateList {
+		rackIDs = append(rackIDs, rackStateList[idx].Rack.ID)
+		targetSizes[rackStateList[idx].Rack.ID] = rackStateList[idx].Size
+	}
+
+	for idx := range racksToDelete {
+		rackIDs = append(rackIDs, racksToDelete[idx].ID)
+		targetSizes[racksToDelete[idx].ID] = 0
+	}
+
+	currentSizes := make(map[int]int, len(rackIDs))
+
+	var currentTotal int
+
+	for _, rackID := range rackIDs {
+		found := &appsv1.StatefulSet{}
+		stsName := utils.GetNamespacedNameForSTSOrConfigMap(r.aeroCluster, rackID)
+
+		if err := r.Client.Get(context.TODO(), stsName, found); err != nil {
+			if !errors.IsNotFound(err) {
+				return nil, err
+			}
+
+			currentSizes[rackID] = 0
+
+			continue
+		}
+
+		currentSizes[rackID] = int(*found.Spec.Replicas)
+		currentTotal += currentSizes[rackID]
+	}
+
+	allowed, err := r.isDisruptionAllowed()
+	if err != nil {
+		return nil, err
+	}
+
+	// The racks to delete keep their size until the rack deletion is allowed by the maintenance windows.
+	if !allowed {
+		for idx := range racksToDelete {
+			targetSizes[racksToDelete[idx].ID] = currentSizes[racksToDelete[idx].ID]
+		}
+	}
+
+	// A new cluster is created at its configured size.
+	if currentTotal == 0 || reflect.DeepEqual(currentSizes, targetSizes) {
+		return nil, nil
+	}
+
+	waitReason, serverHealth := r.getRackRebalanceWaitReason(currentTotal, ignorablePodNames)
+	if waitReason != "" {
+		r.Log.Info("Waiting for the previous rack rebalance step", "reason", waitReason)
+		return currentSizes, nil
+	}
+
+	stepSizes := getRackRebalanceStepSizes(rackIDs, currentSizes, targetSizes, r.getRackRebalanceStepSize(serverHealth))
+	if reflect.DeepEqual(stepSizes, targetSizes) {
+		return nil, nil
+	}
+
+	r.Log.Info(
+		"Rebalancing racks in steps", "currentSizes", currentSizes, "stepSizes", stepSizes,
+		"targetSizes", targetSizes,
+	)
+
+	return stepSizes, nil
+}
+
+// getRackRebalanceWaitReason returns the reason to wait before taking the next rebalance step, it is empty if the
+// next step can be taken. The server health is returned to size the next step.
+func (r *SingleClusterReconciler) getRackRebalanceWaitReason(
+	currentSize int, ignorablePodNames sets.Set[string],
+) (string, *asdbv1.ServerHealthStatus) {
+	podList, err := r.getClusterPodList()
+	if err != nil {
+		return fmt.Sprintf("failed to list pods: %v", err), nil
+	}
+
+	if len(podList.Items) != currentSize {
+		return fmt.Sprintf("cluster has %d pods, expected %d", len(podList.Items), currentSize), nil
+	}
+
+	for idx := range podList.Items {
+		pod := &podList.Items[idx]
+		if !ignorablePodNames.Has(pod.Name) && !utils.IsPodRunningAndReady(pod) {
+			return fmt.Sprintf("pod %s is not ready", pod.Name), nil
+		}
+	}
+
+	serverHealth, err := r.getServerHealth()
+	if err != nil {
+		return fmt.Sprintf("failed to get server health: %v", err), nil
+	}
+
+	if remaining := serverHealth.MigrateTxPartitionsRemaining + serverHealth.MigrateRxPartitionsRemaining; remaining != 0 {
+		return fmt.Sprintf("%d partitions remaining to migrate", remaining), nil
+	}
+
+	return "", serverHealth
+}
+
+// getRackRebalanceStepSize returns the maximum number of pods added and removed in a step. It is limited by
+// rebalancePolicy.maxDataInMotion using the average data used per node.
+func (r *SingleClusterReconciler) getRackRebalanceStepSize(serverHealth *asdbv1.ServerHealthStatus) int {
+	policy := r.aeroCluster.Spec.RackConfig.RebalancePolicy
+	stepSize := max(int(policy.MaxPodsPerStep), 1)
+
+	if policy.MaxDataInMotion == nil || serverHealth.NodesResponded == 0 {
+		return stepSize
+	}
+
+	var dataUsedBytes int64
+
+	for idx := range serverHealth.Namespaces {
+		dataUsedBytes += serverHealth.Namespaces[idx].DataUsedBytes
+	}
+
+	podDataBytes := dataUsedBytes / int64(serverHealth.NodesResponded)
+	if podDataBytes == 0 {
+		return stepSize
+	}
+
+	return max(min(stepSize, int(policy.MaxDataInMotion.Value()/podDataBytes)), 1)
+}
+
+// getRackRebalanceStepSizes moves the current rack sizes towards the target sizes by adding and removing up to
+// stepSize pods. Pods are added to the racks with the largest deficit and removed from the racks with the largest
+// surplus. Pods are not added while the cluster exceeds its target size by a step, so the pods added by the
+// previous step are balanced by removals before more pods are added.
+func getRackRebalanceStepSizes(rackIDs []int, currentSizes, targetSizes map[int]int, stepSize int) map[int]int {
+	stepSizes := make(map[int]int, len(rackIDs))
+
+	var currentTotal, targetTotal, deficit, surplus int
+
+	for _, rackID := range rackIDs {
+		stepSizes[rackID] = currentSizes[rackID]
+		currentTotal += currentSizes[rackID]
+		targetTotal += targetSizes[rackID]
+
+		if diff := targetSizes[rackID] - currentSizes[rackID]; diff > 0 {
+			deficit += diff
+		} else {
+			surplus -= diff
+		}
+	}
+
+	for toAdd := min(stepSize, deficit, max(targetTotal+stepSize-currentTotal, 0)); toAdd > 0; toAdd-- {
+		rackID := getRackWithLargestGap(rackIDs, func(rackID int) int {
+			return targetSizes[rackID] - stepSizes[rackID]
+		})
+		stepSizes[rackID]++
+	}
+
+	for toRemove := min(stepSize, surplus); toRemove > 0; toRemove-- {
+		rackID := getRackWithLargestGap(rackIDs, func(rackID int) int {
+			return stepSizes[rackID] - targetSizes[rackID]
+		})
+		stepSizes[rackID]--
+	}
+
+	return stepSizes
+}
+
+// getRackWithLargestGap returns the first rack with the largest gap.
+func getRackWithLargestGap(rackIDs []int, gap func(rackID int) int) int {
+	largestGapRackID := rackIDs[0]
+	largestGap := gap(largestGapRackID)
+
+	for _, rackID := range rackIDs[1:] {
+		if rackGap := gap(rackID); rackGap > largestGap {
+			largestGapRackID = rackID
+			largestGap = rackGap
+		}
+	}
+
+	return largestGapRackID
+}
+
+// waitForRackRebalance requeues the reconcile until the racks are rebalanced to their configured sizes.
+func (r *SingleClusterReconciler) waitForRackRebalance(stepSizes map[int]int) common.ReconcileResult {
+	msg := fmt.Sprintf("Racks are being rebalanced, current step rack sizes %v", stepSizes)
+
+	if err := r.setStatusConditions(
+		r.newCondition(
+			asdbv1.ConditionTypeProgressing, metav1.ConditionTrue, asdbv1.ConditionReasonRackRebalancing, msg,
+		),
+	); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	return common.ReconcileRequeueAfter(rackRebalanceRefreshPeriod)
+}
@@ -0,0 +1,216 @@
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+func TestGetRackRebalanceStepSizes(t *testing.T) {
+	tests := []struct {
+		name         string
+		rackIDs      []int
+		currentSizes map[int]int
+		targetSizes  map[int]int
+		stepSize     int
+		want         map[int]int
+	}{
+		{
+			name:         "rack added",
+			rackIDs:      []int{1, 2, 3},
+			currentSizes: map[int]int{1: 3, 2: 3, 3: 0},
+			targetSizes:  map[int]int{1: 2, 2: 2, 3: 2},
+			stepSize:     1,
+			want:         map[int]int{1: 2, 2: 3, 3: 1},
+		},
+		{
+			name:         "rack removed",
+			rackIDs:      []int{1, 2, 3},
+			currentSizes: map[int]int{1: 2, 2: 2, 3: 2},
+			targetSizes:  map[int]int{1: 3, 2: 3, 3: 0},
+			stepSize:     1,
+			want:         map[int]int{1: 3, 2: 2, 3: 1},
+		},
+		{
+			name:         "total size increased",
+			rackIDs:      []int{1, 2},
+			currentSizes: map[int]int{1: 2, 2: 2},
+			targetSizes:  map[int]int{1: 4, 2: 4},
+			stepSize:     2,
+			want:         map[int]int{1: 3, 2: 3},
+		},
+		{
+			name:         "total size decreased",
+			rackIDs:      []int{1, 2},
+			currentSizes: map[int]int{1: 4, 2: 4},
+			targetSizes:  map[int]int{1: 3, 2: 2},
+			stepSize:     2,
+			want:         map[int]int{1: 3, 2: 3},
+		},
+		{
+			name:         "no pods added while the cluster exceeds its target size by a step",
+			rackIDs:      []int{1, 2, 3},
+			currentSizes: map[int]int{1: 3, 2: 3, 3: 1},
+			targetSizes:  map[int]int{1: 2, 2: 2, 3: 2},
+			stepSize:     1,
+			want:         map[int]int{1: 2, 2: 3, 3: 1},
+		},
+		{
+			name:         "step larger than the remaining changes",
+			rackIDs:      []int{1, 2, 3},
+			currentSizes: map[int]int{1: 3, 2: 3, 3: 0},
+			targetSizes:  map[int]int{1: 2, 2: 2, 3: 2},
+			stepSize:     5,
+			want:         map[int]int{1: 2, 2: 2, 3: 2},
+		},
+	}
+
+	for _, test := range tests {
+		got := getRackRebalanceStepSizes(test.rackIDs, test.currentSizes, test.targetSizes, test.stepSize)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected step sizes %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestGetRackWithLargestGap(t *testing.T) {
+	tests := []struct {
+		name    string
+		rackIDs []int
+		gaps    map[int]int
+		want    int
+	}{
+		{
+			name:    "single rack",
+			rackIDs: []int{1},
+			gaps:    map[int]int{1: 0},
+			want:    1,
+		},
+		{
+			name:    "largest gap",
+			rackIDs: []int{1, 2, 3},
+			gaps:    map[int]int{1: 1, 2: 3, 3: 2},
+			want:    2,
+		},
+		{
+			name:    "first rack on a tie",
+			rackIDs: []int{3, 1, 2},
+			gaps:    map[int]int{1: 2, 2: 1, 3: 2},
+			want:    3,
+		},
+		{
+			name:    "negative gaps",
+			rackIDs: []int{1, 2},
+			gaps:    map[int]int{1: -2, 2: -1},
+			want:    2,
+		},
+	}
+
+	for _, test := range tests {
+		got := getRackWithLargestGap(test.rackIDs, func(rackID int) int {
+			return test.gaps[rackID]
+		})
+		if got != test.want {
+			t.Errorf("%s: expected rack %d, got %d", test.name, test.want, got)
+		}
+	}
+}
+
+func TestGetRackRebalanceStepSize(t *testing.T) {
+	tests := []struct {
+		name            string
+		maxPodsPerStep  int32
+		maxDataInMotion string
+		nodesResponded  int32
+		dataUsedBytes   []int64
+		want            int
+	}{
+		{
+			name:           "default step size",
+			nodesResponded: 2,
+			want:           1,
+		},
+		{
+			name:           "max pods per step",
+			maxPodsPerStep: 3,
+			nodesResponded: 2,
+			want:           3,
+		},
+		{
+			name:            "limited by max data in motion",
+			maxPodsPerStep:  4,
+			maxDataInMotion: "25Gi",
+			nodesResponded:  2,
+			dataUsedBytes:   []int64{10 << 30, 10 << 30},
+			want:            2,
+		},
+		{
+			name:            "max data in motion above max pods per step",
+			maxPodsPerStep:  4,
+			maxDataInMotion: "50Gi",
+			nodesResponded:  2,
+			dataUsedBytes:   []int64{20 << 30},
+			want:            4,
+		},
+		{
+			name:            "at least one pod per step",
+			maxPodsPerStep:  4,
+			maxDataInMotion: "10Gi",
+			nodesResponded:  2,
+			dataUsedBytes:   []int64{40 << 30},
+			want:            1,
+		},
+		{
+			name:            "no data used",
+			maxPodsPerStep:  4,
+			maxDataInMotion: "10Gi",
+			nodesResponded:  2,
+			want:            4,
+		},
+		{
+			name:            "no node responded",
+			maxPodsPerStep:  4,
+			maxDataInMotion: "10Gi",
+			dataUsedBytes:   []int64{40 << 30},
+			want:            4,
+		},
+	}
+
+	for _, test := range tests {
+		policy := &asdbv1.RackRebalancePolicy{
+			MaxPodsPerStep: test.maxPodsPerStep,
+		}
+
+		if test.maxDataInMotion != "" {
+			maxDataInMotion := resource.MustParse(test.maxDataInMotion)
+			policy.MaxDataInMotion = &maxDataInMotion
+		}
+
+		r := &SingleClusterReconciler{
+			aeroCluster: &asdbv1.AerospikeCluster{
+				Spec: asdbv1.AerospikeClusterSpec{
+					RackConfig: asdbv1.RackConfig{
+						RebalancePolicy: policy,
+					},
+				},
+			},
+		}
+
+		serverHealth := &asdbv1.ServerHealthStatus{
+			NodesResponded: test.nodesResponded,
+		}
+
+		for _, dataUsedBytes := range test.dataUsedBytes {
+			serverHealth.Namespaces = append(
+				serverHealth.Namespaces, asdbv1.NamespaceHealthStatus{DataUsedBytes: dataUsedBytes},
+			)
+		}
+
+		if got := r.getRackRebalanceStepSize(serverHealth); got != test.want {
+			t.Errorf("%s: expected step size %d, got %d", test.name, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package cluster
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+// rollingUpdateBatchRefreshPeriod is the period in seconds at which the gates of rackConfig.rollingUpdatePolicy
+// are checked.
+const rollingUpdateBatchRefreshPeriod = 10
+
+// getRackStateListInUpdateOrder returns the racks in the order given by rackConfig.rollingUpdateStrategy.rackOrder.
+// Racks not in rackOrder follow the ordered racks, in the order of rackConfig.racks.
+func (r *SingleClusterReconciler) getRackStateListInUpdateOrder(rackStateList []RackState) []RackState {
+	strategy := r.aeroCluster.Spec.RackConfig.RollingUpdateStrategy
+	if strategy == nil || len(strategy.RackOrder) == 0 {
+		return rackStateList
+	}
+
+	rackOrder := make(map[int]int, len(strategy.RackOrder))
+	for idx, rackID := range strategy.RackOrder {
+		rackOrder[rackID] = idx
+	}
+
+	orderedRackStateList := make([]RackState, 0, len(rackStateList))
+	unorderedRackStateList := make([]RackState, 0, len(rackStateList))
+
+	for idx := range rackStateList {
+		if _, ok := rackOrder[rackStateList[idx].Rack.ID]; ok {
+			orderedRackStateList = append(orderedRackStateList, rackStateList[idx])
+		} else {
+			unorderedRackStateList = append(unorderedRackStateList, rackStateList[idx])
+		}
+	}
+
+	sort.SliceStable(
+		orderedRackStateList, func(i, j int) bool {
+			return rackOrder[orderedRackStateList[i].Rack.ID] < rackOrder[orderedRackStateList[j].Rack.ID]
+		},
+	)
+
+	return append(orderedRackStateList, unorderedRackStateList...)
+}
+
+func (r *SingleClusterReconciler) getPauseAfterRack() time.Duration {
+	strategy := r.aeroCluster.Spec.RackConfig.RollingUpdateStrategy
+	if strategy == nil || strategy.PauseAfterRack == nil {
+		return 0
+	}
+
+	return strategy.PauseAfterRack.Duration
+}
+
+// pauseAfterRack pauses the rolling operation after the rack is updated, if rollingUpdateStrategy.pauseAfterRack
+// is given. The next racks are updated once the pause is over. There is no pause after the last rack in the update
+// order, as no rack is updated after it.
+func (r *SingleClusterReconciler) pauseAfterRack(rackState *RackState) error {
+	pauseAfterRack := r.getPauseAfterRack()
+	if pauseAfterRack <= 0 || r.isLastRackInUpdateOrder(rackState.Rack.ID) {
+		return nil
+	}
+
+	pause := &asdbv1.RollingUpdatePauseStatus{
+		RackID:      rackState.Rack.ID,
+		PausedUntil: metav1.NewTime(time.Now().Add(pauseAfterRack)),
+	}
+
+	if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.RollingUpdatePause = pause
+	}); err != nil {
+		return fmt.Errorf("failed to update rolling update pause status: %v", err)
+	}
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "RackRollingUpdatePaused",
+		"[rack-%d] Pausing rolling update of next racks for %s", rackState.Rack.ID, pauseAfterRack,
+	)
+
+	return nil
+}
+
+// isLastRackInUpdateOrder returns true if the rack is the last of the configured racks in the update order.
+func (r *SingleClusterReconciler) isLastRackInUpdateOrder(rackID int) bool {
+	orderedRackStateList := r.getRackStateListInUpdateOrder(getConfiguredRackStateList(r.aeroCluster))
+
+	return len(orderedRackStateList) != 0 && orderedRackStateList[len(orderedRackStateList)-1].Rack.ID == rackID
+}
+
+// waitForRollingUpdatePause requeues the reconcile until the pause after the last updated rack is over.
+func (r *SingleClusterReconciler) waitForRollingUpdatePause() common.ReconcileResult {
+	pause := r.aeroCluster.Status.RollingUpdatePause
+	if pause == nil {
+		return common.ReconcileSuccess()
+	}
+
+	remaining := time.Until(pause.PausedUntil.Time)
+
+	// The pause is over or has been removed from the spec.
+	if remaining <= 0 || r.getPauseAfterRack() <= 0 {
+		if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+			status.RollingUpdatePause = nil
+		}); err != nil {
+			return common.ReconcileError(fmt.Errorf("failed to update rolling update pause status: %v", err))
+		}
+
+		return common.ReconcileSuccess()
+	}
+
+	r.Log.Info(
+		"Rolling update is paused after rack", "rackID", pause.RackID, "pausedUntil", pause.PausedUntil,
+	)
+
+	return common.ReconcileRequeueAfter(int(math.Ceil(remaining.Seconds())))
+}
+
+// waitForRollingUpdateBatch requeues the reconcile until the gates of rackConfig.rollingUpdatePolicy are passed,
+// i.e. the pods are ready for minReadySeconds, the migrations are complete and the post batch checks pass.
+// It is called before a batch of pods is restarted so that the previous batch settles first.
+func (r *SingleClusterReconciler) waitForRollingUpdateBatch(ignorablePodNames sets.Set[string]) common.ReconcileResult {
+	policy := r.aeroCluster.Spec.RackConfig.RollingUpdatePolicy
+	if policy == nil {
+		return common.ReconcileSuccess()
+	}
+
+	podList, err := r.getClusterPodList()
+	if err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to list pods: %v", err))
+	}
+
+	minReadyDuration := time.Duration(policy.MinReadySeconds) * time.Second
+
+	var (
+		readyPods []*corev1.Pod
+		wait      time.Duration
+	)
+
+	for idx := range podList.Items {
+		pod := &podList.Items[idx]
+		if ignorablePodNames.Has(pod.Name) || !utils.IsPodRunningAndReady(pod) {
+			continue
+		}
+
+		readyPods = append(readyPods, pod)
+
+		if readyTime := getPodReadyTime(pod); readyTime != nil {
+			wait = max(wait, time.Until(readyTime.Add(minReadyDuration)))
+		}
+	}
+
+	if wait > 0 {
+		r.Log.Info("Waiting for pods to be ready for minReadySeconds before the next batch", "remaining", wait.String())
+
+		return common.ReconcileRequeueAfter(int(math.Ceil(wait.Seconds())))
+	}
+
+	if policy.WaitForMigrations {
+		serverHealth, hErr := r.getServerHealth()
+		if hErr != nil {
+			return common.ReconcileError(hErr)
+		}
+
+		if remaining := serverHealth.MigrateTxPartitionsRemaining +
+			serverHealth.MigrateRxPartitionsRemaining; remaining > 0 {
+			r.Log.Info(
+				"Waiting for migrations to complete before the next batch", "remainingPartitions", remaining,
+			)
+
+			return common.ReconcileRequeueAfter(rollingUpdateBatchRefreshPeriod)
+		}
+	}
+
+	if err = r.runInfoChecks(policy.PostBatchChecks, readyPods); err != nil {
+		r.Log.Info("Waiting for post batch checks to pass before the next batch", "reason", err.Error())
+
+		r.Recorder.Eventf(
+			r.aeroCluster, corev1.EventTypeWarning, "RollingUpdateBatchCheckFailed",
+			"Waiting before the next batch: %v", err,
+		)
+
+		return common.ReconcileRequeueAfter(rollingUpdateBatchRefreshPeriod)
+	}
+
+	return common.ReconcileSuccess()
+}
+
+// getPodReadyTime returns the time when the pod became ready.
+func getPodReadyTime(pod *corev1.Pod) *metav1.Time {
+	for idx := range pod.Status.Conditions {
+		cond := &pod.Status.Conditions[idx]
+		if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+			return &cond.LastTransitionTime
+		}
+	}
+
+	return nil
+}
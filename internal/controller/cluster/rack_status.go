@@ -0,0 +1,187 @@
+package cluster
+
+import (
+	"context"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+// getPodRestartType converts the internal RestartType to the one reported in status.
+// Returns false if the pod does not need any update.
+func getPodRestartType(restartType RestartType) (asdbv1.PodRestartType, bool) {
+	switch restartType {
+	case podRestart:
+		return asdbv1.PodRestartTypePodRestart, true
+	case quickRestart:
+		return asdbv1.PodRestartTypeQuickRestart, true
+	case noRestartUpdateConf:
+		return asdbv1.PodRestartTypeUpdateConf, true
+	case noRestart:
+	}
+
+	return "", false
+}
+
+// getRackStatus builds the status of a rack from its StatefulSet and pods.
+// restartTypeMap is the RestartType of the rack pods, it can be nil if pending restarts are not known.
+func (r *SingleClusterReconciler) getRackStatus(
+	rack *asdbv1.Rack, desiredSize int, restartTypeMap map[string]RestartType, deleting bool,
+) (*asdbv1.RackStatus, error) {
+	rackStatus := &asdbv1.RackStatus{
+		ID:          rack.ID,
+		Zone:        rack.Zone,
+		Region:      rack.Region,
+		DesiredPods: int32(desiredSize),
+		Deleting:    deleting,
+	}
+
+	found := &appsv1.StatefulSet{}
+	stsName := utils.GetNamespacedNameForSTSOrConfigMap(r.aeroCluster, rack.ID)
+
+	if err := r.Client.Get(context.TODO(), stsName, found); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+
+		// Rack is not created yet.
+		return rackStatus, nil
+	}
+
+	rackStatus.CurrentRevision = found.Status.CurrentRevision
+	rackStatus.UpdateRevision = found.Status.UpdateRevision
+
+	podList, err := r.getRackPodList(rack.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rackStatus.CurrentPods = int32(len(podList.Items))
+
+	for idx := range podList.Items {
+		if utils.IsPodRunningAndReady(&podList.Items[idx]) {
+			rackStatus.ReadyPods++
+		}
+	}
+
+	for podName, restartType := range restartTypeMap {
+		if podRestartType, ok := getPodRestartType(restartType); ok {
+			rackStatus.PodsPendingRestart = append(
+				rackStatus.PodsPendingRestart, asdbv1.PodPendingRestart{
+					PodName: podName, RestartType: podRestartType,
+				},
+			)
+		}
+	}
+
+	sort.Slice(
+		rackStatus.PodsPendingRestart, func(i, j int) bool {
+			return rackStatus.PodsPendingRestart[i].PodName < rackStatus.PodsPendingRestart[j].PodName
+		},
+	)
+
+	return rackStatus, nil
+}
+
+// getRacksStatus builds the status of all configured racks and of the racks being deleted.
+// Pending restarts are not computed here, they are set per rack while the rack is reconciled.
+func (r *SingleClusterReconciler) getRacksStatus(
+	rackStateList []RackState, racksToDelete []asdbv1.Rack,
+) ([]asdbv1.RackStatus, error) {
+	racksStatus := make([]asdbv1.RackStatus, 0, len(rackStateList)+len(racksToDelete))
+
+	for idx := range rackStateList {
+		rackStatus, err := r.getRackStatus(rackStateList[idx].Rack, rackStateList[idx].Size, nil, false)
+		if err != nil {
+			return nil, err
+		}
+
+		racksStatus = append(racksStatus, *rackStatus)
+	}
+
+	for idx := range racksToDelete {
+		rackStatus, err := r.getRackStatus(&racksToDelete[idx], 0, nil, true)
+		if err != nil {
+			return nil, err
+		}
+
+		racksStatus = append(racksStatus, *rackStatus)
+	}
+
+	sort.Slice(
+		racksStatus, func(i, j int) bool {
+			return racksStatus[i].ID < racksStatus[j].ID
+		},
+	)
+
+	return racksStatus, nil
+}
+
+// setRacksStatus replaces the racks status in the AerospikeCluster status.
+func (r *SingleClusterReconciler) setRacksStatus(racksStatus []asdbv1.RackStatus) error {
+	return r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.Racks = racksStatus
+	})
+}
+
+// setRackStatus updates the status of a single rack in the AerospikeCluster status.
+func (r *SingleClusterReconciler) setRackStatus(rackStatus *asdbv1.RackStatus) error {
+	return r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		for idx := range status.Racks {
+			if status.Racks[idx].ID == rackStatus.ID {
+				status.Racks[idx] = *rackStatus
+				return
+			}
+		}
+
+		status.Racks = append(status.Racks, *rackStatus)
+		sort.Slice(
+			status.Racks, func(i, j int) bool {
+				return status.Racks[i].ID < status.Racks[j].ID
+			},
+		)
+	})
+}
+
+// updateRackStatus refreshes the status of the given rack along with the pods pending restart.
+func (r *SingleClusterReconciler) updateRackStatus(
+	rackState *RackState, restartTypeMap map[string]RestartType,
+) error {
+	rackStatus, err := r.getRackStatus(rackState.Rack, rackState.Size, restartTypeMap, false)
+	if err != nil {
+		return err
+	}
+
+	return r.setRackStatus(rackStatus)
+}
+
+// getUpgradeRestartTypeMap returns podRestart for all the rack pods that are not on the desired images.
+func (r *SingleClusterReconciler) getUpgradeRestartTypeMap(
+	rackID int, ignorablePodNames sets.Set[string],
+) (map[string]RestartType, error) {
+	podList, err := r.getRackPodList(rackID)
+	if err != nil {
+		return nil, err
+	}
+
+	restartTypeMap := make(map[string]RestartType)
+
+	for idx := range podList.Items {
+		pod := &podList.Items[idx]
+
+		if ignorablePodNames.Has(pod.Name) {
+			continue
+		}
+
+		if !r.isPodOnDesiredImage(pod, false) {
+			restartTypeMap[pod.Name] = podRestart
+		}
+	}
+
+	return restartTypeMap, nil
+}
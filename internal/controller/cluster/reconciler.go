@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	k8sRuntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -22,7 +25,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	as "github.com/aerospike/aerospike-client-go/v7"
 	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
 	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
 	"github.com/aerospike/aerospike-kubernetes-operator/pkg/jsonpatch"
@@ -40,6 +42,12 @@ type SingleClusterReconciler struct {
 	KubeConfig  *rest.Config
 	Scheme      *k8sRuntime.Scheme
 	Log         logr.Logger
+
+	// deferredWork is the disruptive work deferred until the next maintenance window in this reconcile.
+	deferredWork []asdbv1.DisruptiveWork
+
+	// serverHealthRefreshes has the time of the server health refresh requeued for each cluster.
+	serverHealthRefreshes *sync.Map
 }
 
 func (r *SingleClusterReconciler) Reconcile() (result ctrl.Result, recErr error) {
@@ -54,10 +62,31 @@ func (r *SingleClusterReconciler) Reconcile() (result ctrl.Result, recErr error)
 		if recErr != nil {
 			r.Log.Error(recErr, "Reconcile failed")
 
-			if err := r.setStatusPhase(asdbv1.AerospikeClusterError); err != nil {
+			if err := r.setStatusPhase(
+				asdbv1.AerospikeClusterError,
+				r.newCondition(
+					asdbv1.ConditionTypeDegraded, metav1.ConditionTrue, asdbv1.ConditionReasonReconcileFailed,
+					recErr.Error(),
+				),
+				r.newCondition(
+					asdbv1.ConditionTypeAvailable, metav1.ConditionFalse, asdbv1.ConditionReasonReconcileFailed,
+					"Reconcile failed, pods may not be running and ready",
+				),
+			); err != nil {
 				recErr = err
 			}
 		}
+
+		// Record the outcome of this reconcile, the cluster may be gone if it was being deleted.
+		if r.aeroCluster.ObjectMeta.DeletionTimestamp.IsZero() {
+			if err := r.updateReconcileStatus(recErr); err != nil {
+				r.Log.Error(err, "Failed to update reconcile status")
+			}
+
+			if err := r.updateOperationHistory(recErr); err != nil {
+				r.Log.Error(err, "Failed to update operation history")
+			}
+		}
 	}()
 
 	// Check DeletionTimestamp to see if the cluster is being deleted
@@ -86,13 +115,51 @@ func (r *SingleClusterReconciler) Reconcile() (result ctrl.Result, recErr error)
 
 	// Pause the reconciliation for the AerospikeCluster if the paused field is set to true.
 	// Deletion of the AerospikeCluster will not be paused.
+	// The server health snapshot is still refreshed if opted in, e.g. to track the XDR queue of a migrated cluster.
 	if asdbv1.GetBool(r.aeroCluster.Spec.Paused) {
 		r.Log.Info("Reconciliation is paused for this AerospikeCluster")
+
+		if r.aeroCluster.Annotations[asdbv1.RefreshServerHealthWhilePausedAnnotation] == "true" {
+			return r.refreshServerHealth().GetResult()
+		}
+
 		return reconcile.Result{}, nil
 	}
 
+	// Only compute the changes needed to reach the spec if the reconcile policy is PlanOnly.
+	// Nothing is applied to the cluster in this mode.
+	if r.isPlanOnly() {
+		r.Log.Info("Computing reconcile plan for this AerospikeCluster")
+		return r.reconcilePlan().GetResult()
+	}
+
+	// Skip the full reconcile if this is the requeue for the server health refresh, and nothing has changed since
+	// the last successful reconcile.
+	if r.isServerHealthRefreshOnly() {
+		r.Log.Info("Refreshing server health snapshot")
+		return r.refreshServerHealth().GetResult()
+	}
+
 	// Set the status to AerospikeClusterInProgress before starting any operations
-	if err := r.setStatusPhase(asdbv1.AerospikeClusterInProgress); err != nil {
+	if err := r.setStatusPhase(
+		asdbv1.AerospikeClusterInProgress,
+		r.newCondition(
+			asdbv1.ConditionTypeProgressing, metav1.ConditionTrue, asdbv1.ConditionReasonReconciling,
+			"Reconciling AerospikeCluster",
+		),
+		r.getConfigDriftCondition(),
+	); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.removeReconcilePlan(); err != nil {
+		r.Log.Error(err, "Failed to remove reconcile plan from status")
+		return reconcile.Result{}, err
+	}
+
+	// Show the queued on-demand operations as pending or running before executing them.
+	if err := r.updateOperationHistory(nil); err != nil {
+		r.Log.Error(err, "Failed to update operation history")
 		return reconcile.Result{}, err
 	}
 
@@ -129,6 +196,18 @@ func (r *SingleClusterReconciler) Reconcile() (result ctrl.Result, recErr error)
 		return reconcile.Result{}, recErr
 	}
 
+	// Record the nodes of the pods selected for scale-down before the racks are scaled down.
+	if err := r.reconcileScaleDownSelectionStatus(); err != nil {
+		r.Log.Error(err, "Failed to update scale-down selection status")
+
+		recErr = err
+
+		return reconcile.Result{}, recErr
+	}
+
+	// On-demand operation being executed by this reconcile, operations are executed one at a time.
+	currentOp := r.getCurrentOperation()
+
 	// Reconcile all racks
 	if res := r.reconcileRacks(); !res.IsSuccess {
 		if res.Err != nil {
@@ -178,7 +257,7 @@ func (r *SingleClusterReconciler) Reconcile() (result ctrl.Result, recErr error)
 	}
 
 	// Check if there is any node with quiesce status. We need to undo that
-	// It may have been left from previous steps
+	// It may have been left from previous steps. Pods of the Quiesce operations in the spec stay quiesced.
 	allHostConns, err := r.newAllHostConnWithOption(ignorablePodNames)
 	if err != nil {
 		e := fmt.Errorf(
@@ -190,10 +269,7 @@ func (r *SingleClusterReconciler) Reconcile() (result ctrl.Result, recErr error)
 		return reconcile.Result{}, e
 	}
 
-	if err = deployment.InfoQuiesceUndo(
-		r.Log,
-		r.getClientPolicy(), allHostConns,
-	); err != nil {
+	if err = r.reconcileQuiescedPods(r.getClientPolicy(), allHostConns, ignorablePodNames); err != nil {
 		r.Log.Error(err, "Failed to check for Quiesced nodes")
 
 		recErr = err
@@ -211,11 +287,24 @@ func (r *SingleClusterReconciler) Reconcile() (result ctrl.Result, recErr error)
 			r.aeroCluster.Name,
 		)
 
+		if cErr := r.setStatusConditions(
+			r.newCondition(
+				asdbv1.ConditionTypeACLReconciled, metav1.ConditionFalse, asdbv1.ConditionReasonACLReconcileFailed,
+				err.Error(),
+			),
+		); cErr != nil {
+			r.Log.Error(cErr, "Failed to set ACLReconciled condition")
+		}
+
 		recErr = err
 
 		return reconcile.Result{}, recErr
 	}
 
+	if err = r.setStatusConditions(r.getACLReconciledCondition()); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// Use policy from spec after setting up access control
 	policy := r.getClientPolicy()
 
@@ -256,10 +345,66 @@ func (r *SingleClusterReconciler) Reconcile() (result ctrl.Result, recErr error)
 		// Setup roster
 		if err = r.getAndSetRoster(policy, r.aeroCluster.Spec.RosterNodeBlockList, ignorablePodNames); err != nil {
 			r.Log.Error(err, "Failed to set roster for cluster")
+
+			if cErr := r.setStatusConditions(
+				r.newCondition(
+					asdbv1.ConditionTypeRosterApplied, metav1.ConditionFalse, asdbv1.ConditionReasonRosterApplyFailed,
+					err.Error(),
+				),
+			); cErr != nil {
+				r.Log.Error(cErr, "Failed to set RosterApplied condition")
+			}
+
 			recErr = err
 
 			return reconcile.Result{}, recErr
 		}
+
+		if err = r.setStatusConditions(
+			r.newCondition(
+				asdbv1.ConditionTypeRosterApplied, metav1.ConditionTrue, asdbv1.ConditionReasonRosterApplied,
+				"Roster is set for all strong consistency namespaces",
+			),
+		); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	// Create the missing secondary indexes and drop the ones removed from the spec.
+	indexesBuilding, err := r.reconcileSecondaryIndexes(allHostConns)
+	if err != nil {
+		r.Log.Error(err, "Failed to reconcile secondary indexes")
+		r.Recorder.Eventf(
+			r.aeroCluster, corev1.EventTypeWarning, "SecondaryIndexUpdateFailed",
+			"Failed to reconcile secondary indexes %s/%s: %v", r.aeroCluster.Namespace, r.aeroCluster.Name, err,
+		)
+
+		recErr = err
+
+		return reconcile.Result{}, recErr
+	}
+
+	// Register the UDF modules of the referenced ConfigMaps and remove the ones not referenced anymore.
+	if err = r.reconcileUDFModules(allHostConns); err != nil {
+		r.Log.Error(err, "Failed to reconcile UDF modules")
+
+		recErr = err
+
+		return reconcile.Result{}, recErr
+	}
+
+	// Requeue to execute the next on-demand operation in the queue, if any.
+	if res := r.reconcileOperationQueue(currentOp, allHostConns, ignorablePodNames); !res.IsSuccess {
+		recErr = res.Err
+
+		return res.Result, recErr
+	}
+
+	// The cluster is not updated to the spec until the deferred disruptive work is done in a maintenance window.
+	if res := r.reconcileMaintenanceWindowStatus(); !res.IsSuccess {
+		recErr = res.Err
+
+		return res.Result, recErr
 	}
 
 	// Update the AerospikeCluster status.
@@ -281,9 +426,20 @@ func (r *SingleClusterReconciler) Reconcile() (result ctrl.Result, recErr error)
 		}
 	}
 
+	// Refresh the server health snapshot, the reconcile is requeued for the next refresh if it is enabled.
+	res := r.refreshServerHealth()
+	if !res.IsSuccess {
+		return res.GetResult()
+	}
+
+	// Requeue to refresh the build progress of the secondary indexes.
+	if indexesBuilding && (res.Result.RequeueAfter == 0 || res.Result.RequeueAfter > secondaryIndexRefreshPeriod) {
+		res.Result.RequeueAfter = secondaryIndexRefreshPeriod
+	}
+
 	r.Log.Info("Reconcile completed successfully")
 
-	return reconcile.Result{}, nil
+	return res.GetResult()
 }
 
 func (r *SingleClusterReconciler) recoverIgnorablePods() common.ReconcileResult {
@@ -351,24 +507,9 @@ func (r *SingleClusterReconciler) validateAndReconcileAccessControl(
 		}
 	}
 
-	hosts := make([]*as.Host, 0, len(conns))
-
-	for _, conn := range conns {
-		hosts = append(
-			hosts, &as.Host{
-				Name:    conn.ASConn.AerospikeHostName,
-				TLSName: conn.ASConn.AerospikeTLSName,
-				Port:    conn.ASConn.AerospikePort,
-			},
-		)
-	}
-
-	// Create policy using status, status has current connection info
-	clientPolicy := r.getClientPolicy()
-	aeroClient, err := as.NewClientWithPolicyAndHost(clientPolicy, hosts...)
-
+	aeroClient, err := r.newAerospikeClient(conns)
 	if err != nil {
-		return fmt.Errorf("failed to create aerospike cluster client: %v", err)
+		return err
 	}
 
 	defer aeroClient.Close()
@@ -433,6 +574,45 @@ func (r *SingleClusterReconciler) updateStatus() error {
 	newAeroCluster.Status.AerospikeClusterStatusSpec = *specToStatus
 	newAeroCluster.Status.Phase = asdbv1.AerospikeClusterCompleted
 
+	availableCondition, err := r.getAvailableCondition()
+	if err != nil {
+		return fmt.Errorf("failed to get pods readiness: %v", err)
+	}
+
+	for _, condition := range []metav1.Condition{
+		availableCondition,
+		r.newCondition(
+			asdbv1.ConditionTypeProgressing, metav1.ConditionFalse, asdbv1.ConditionReasonReconcileSucceeded,
+			"Desired spec is rolled out to the cluster",
+		),
+		r.newCondition(
+			asdbv1.ConditionTypeDegraded, metav1.ConditionFalse, asdbv1.ConditionReasonReconcileSucceeded,
+			"Reconcile completed successfully",
+		),
+		r.newCondition(
+			asdbv1.ConditionTypeConfigDriftDetected, metav1.ConditionFalse, asdbv1.ConditionReasonConfigInSync,
+			"Desired aerospikeConfig is applied to all racks",
+		),
+	} {
+		meta.SetStatusCondition(&newAeroCluster.Status.Conditions, condition)
+	}
+
+	// The image upgrade, if any, is completed. The cluster stays degraded after a rollback until the image is changed.
+	newAeroCluster.Status.Upgrade = nil
+
+	// All racks are updated, there are no next racks to pause for.
+	newAeroCluster.Status.RollingUpdatePause = nil
+
+	if rollbackStatus := newAeroCluster.Status.UpgradeRollback; rollbackStatus != nil {
+		if rollbackStatus.Image == r.aeroCluster.Spec.Image {
+			meta.SetStatusCondition(
+				&newAeroCluster.Status.Conditions, r.getUpgradeRolledBackCondition(rollbackStatus),
+			)
+		} else {
+			newAeroCluster.Status.UpgradeRollback = nil
+		}
+	}
+
 	// If IsReadinessProbeEnabled is not enabled, then only check for cluster readiness.
 	// This is to avoid checking cluster readiness for every reconcile as once it is enabled, it will not be disabled.
 	if !newAeroCluster.Status.IsReadinessProbeEnabled {
@@ -447,6 +627,20 @@ func (r *SingleClusterReconciler) updateStatus() error {
 	selector := labels.SelectorFromSet(utils.LabelsForAerospikeCluster(newAeroCluster.Name))
 	newAeroCluster.Status.Selector = selector.String()
 
+	// All racks are reconciled by now, so no rack is pending restart or deletion.
+	racksStatus, err := r.getRacksStatus(getConfiguredRackStateList(r.aeroCluster), nil)
+	if err != nil {
+		return fmt.Errorf("failed to get racks status: %v", err)
+	}
+
+	newAeroCluster.Status.Racks = racksStatus
+
+	// All the queued operations are completed along with the rest of the spec.
+	newAeroCluster.Status.OperationHistory = getOperationHistory(
+		r.aeroCluster.Spec.Operations, newAeroCluster.Status.Operations, newAeroCluster.Status.OperationHistory,
+		asdbv1.GetAllPodNames(newAeroCluster.Status.Pods), nil,
+	)
+
 	err = r.patchStatus(newAeroCluster)
 	if err != nil {
 		return fmt.Errorf("error updating status: %w", err)
@@ -459,16 +653,17 @@ func (r *SingleClusterReconciler) updateStatus() error {
 	return nil
 }
 
-func (r *SingleClusterReconciler) setStatusPhase(phase asdbv1.AerospikeClusterPhase) error {
-	if r.aeroCluster.Status.Phase != phase {
-		if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-			if err := r.Client.Get(context.TODO(), utils.GetNamespacedName(r.aeroCluster), r.aeroCluster); err != nil {
-				return err
-			}
-
-			r.aeroCluster.Status.Phase = phase
+// setStatusPhase sets the status phase along with the given conditions.
+func (r *SingleClusterReconciler) setStatusPhase(
+	phase asdbv1.AerospikeClusterPhase, conditions ...metav1.Condition,
+) error {
+	if r.aeroCluster.Status.Phase != phase || r.isConditionUpdateNeeded(conditions) {
+		if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+			status.Phase = phase
 
-			return r.Client.Status().Update(context.Background(), r.aeroCluster)
+			for idx := range conditions {
+				meta.SetStatusCondition(&status.Conditions, conditions[idx])
+			}
 		}); err != nil {
 			r.Log.Error(err, fmt.Sprintf("Failed to set cluster status to %s", phase))
 			return err
@@ -478,6 +673,61 @@ func (r *SingleClusterReconciler) setStatusPhase(phase asdbv1.AerospikeClusterPh
 	return nil
 }
 
+// updateClusterStatus applies mutate on the status of the latest AerospikeCluster object and updates it.
+// Only the status of the cluster object being reconciled is refreshed, its spec is left untouched so that
+// the ongoing reconcile keeps working on the same spec.
+func (r *SingleClusterReconciler) updateClusterStatus(mutate func(status *asdbv1.AerospikeClusterStatus)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestAeroCluster := &asdbv1.AerospikeCluster{}
+		if err := r.Client.Get(
+			context.TODO(), utils.GetNamespacedName(r.aeroCluster), latestAeroCluster,
+		); err != nil {
+			return err
+		}
+
+		mutate(&latestAeroCluster.Status)
+
+		if err := r.Client.Status().Update(context.TODO(), latestAeroCluster); err != nil {
+			return err
+		}
+
+		// Only the status is copied back. The spec and resourceVersion of the reconciled object are kept, so that
+		// an update of the spec based on a stale object still fails with a conflict.
+		r.aeroCluster.Status = latestAeroCluster.Status
+
+		return nil
+	})
+}
+
+// updateReconcileStatus records the outcome of the current reconcile in the status.
+// A reconcile is considered successful only if it did not fail and the cluster reached the Completed phase.
+func (r *SingleClusterReconciler) updateReconcileStatus(recErr error) error {
+	now := metav1.Now()
+	// Generation that this reconcile worked on, the latest object may have a newer one.
+	generation := r.aeroCluster.Generation
+
+	// Nothing is applied to the cluster while it is paused or only planned.
+	applied := !asdbv1.GetBool(r.aeroCluster.Spec.Paused) && !r.isPlanOnly()
+
+	return r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.LastReconcileTime = &now
+
+		if applied {
+			status.ObservedGeneration = generation
+		}
+
+		if recErr != nil {
+			status.LastReconcileError = recErr.Error()
+		} else {
+			status.LastReconcileError = ""
+
+			if status.Phase == asdbv1.AerospikeClusterCompleted && applied {
+				status.LastSuccessfulReconcileTime = &now
+			}
+		}
+	})
+}
+
 func (r *SingleClusterReconciler) getClusterReadinessStatus() (bool, error) {
 	podList, err := r.getClusterPodList()
 	if err != nil {
@@ -749,11 +999,13 @@ func (r *SingleClusterReconciler) addFinalizer(finalizerName string) error {
 	if !utils.ContainsString(
 		r.aeroCluster.ObjectMeta.Finalizers, finalizerName,
 	) {
+		patch := client.MergeFrom(r.aeroCluster.DeepCopy())
+
 		r.aeroCluster.ObjectMeta.Finalizers = append(
 			r.aeroCluster.ObjectMeta.Finalizers, finalizerName,
 		)
 
-		if err := r.Client.Update(context.TODO(), r.aeroCluster); err != nil {
+		if err := r.Client.Patch(context.TODO(), r.aeroCluster, patch); err != nil {
 			return err
 		}
 	}
@@ -773,12 +1025,14 @@ func (r *SingleClusterReconciler) cleanUpAndRemoveFinalizer(finalizerName string
 			return err
 		}
 
+		patch := client.MergeFrom(r.aeroCluster.DeepCopy())
+
 		// Remove finalizer from the list
 		r.aeroCluster.ObjectMeta.Finalizers = utils.RemoveString(
 			r.aeroCluster.ObjectMeta.Finalizers, finalizerName,
 		)
 
-		if err := r.Client.Update(context.TODO(), r.aeroCluster); err != nil {
+		if err := r.Client.Patch(context.TODO(), r.aeroCluster, patch); err != nil {
 			return err
 		}
 	}
@@ -1030,13 +1284,15 @@ func (r *SingleClusterReconciler) getPVCUid(ctx context.Context, pod *corev1.Pod
 
 func (r *SingleClusterReconciler) AddAPIVersionLabel(ctx context.Context) error {
 	aeroCluster := r.aeroCluster
+	patch := client.MergeFrom(aeroCluster.DeepCopy())
+
 	if aeroCluster.Labels == nil {
 		aeroCluster.Labels = make(map[string]string)
 	}
 
 	aeroCluster.Labels[asdbv1.AerospikeAPIVersionLabel] = asdbv1.AerospikeAPIVersion
 
-	return r.Client.Update(ctx, aeroCluster, common.UpdateOption)
+	return r.Client.Patch(ctx, aeroCluster, patch, common.PatchOption)
 }
 
 func (r *SingleClusterReconciler) IsReclusterNeeded() bool {
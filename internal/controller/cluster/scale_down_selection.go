@@ -0,0 +1,150 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+// reconcileScaleDownSelectionStatus records the Kubernetes node of each pod selected in
+// spec.scaleDownSelection.podNames. The node is recorded only once, so a selected pod that is not removed by the
+// scale-down is replaced only once.
+func (r *SingleClusterReconciler) reconcileScaleDownSelectionStatus() error {
+	selection := r.aeroCluster.Spec.ScaleDownSelection
+
+	var recordedPodK8sNodes map[string]string
+	if r.aeroCluster.Status.ScaleDownSelection != nil {
+		recordedPodK8sNodes = r.aeroCluster.Status.ScaleDownSelection.PodK8sNodes
+	}
+
+	podK8sNodes := make(map[string]string)
+
+	if selection != nil && len(selection.PodNames) != 0 {
+		var podList *corev1.PodList
+
+		for _, podName := range selection.PodNames {
+			if nodeName, ok := recordedPodK8sNodes[podName]; ok {
+				podK8sNodes[podName] = nodeName
+				continue
+			}
+
+			if podList == nil {
+				var err error
+
+				podList, err = r.getClusterPodList()
+				if err != nil {
+					return fmt.Errorf("failed to list pods: %v", err)
+				}
+			}
+
+			for idx := range podList.Items {
+				if podList.Items[idx].Name == podName && podList.Items[idx].Spec.NodeName != "" {
+					podK8sNodes[podName] = podList.Items[idx].Spec.NodeName
+				}
+			}
+		}
+	}
+
+	if len(podK8sNodes) == 0 {
+		if r.aeroCluster.Status.ScaleDownSelection == nil {
+			return nil
+		}
+
+		return r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+			status.ScaleDownSelection = nil
+		})
+	}
+
+	if reflect.DeepEqual(podK8sNodes, recordedPodK8sNodes) {
+		return nil
+	}
+
+	r.Log.Info("Recording Kubernetes nodes of pods selected for scale-down", "podK8sNodes", podK8sNodes)
+
+	return r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.ScaleDownSelection = &asdbv1.ScaleDownSelectionStatus{
+			PodK8sNodes: podK8sNodes,
+		}
+	})
+}
+
+// getAvoidedK8sNodes returns the Kubernetes nodes on which the pods are not scheduled, i.e. the k8sNodeBlockList
+// nodes and the nodes of the pods selected for scale-down.
+func (r *SingleClusterReconciler) getAvoidedK8sNodes() []string {
+	nodes := sets.NewString(r.aeroCluster.Spec.K8sNodeBlockList...)
+
+	if r.aeroCluster.Spec.ScaleDownSelection != nil {
+		nodes.Insert(r.aeroCluster.Spec.ScaleDownSelection.K8sNodeNames...)
+	}
+
+	if r.aeroCluster.Status.ScaleDownSelection != nil {
+		for _, nodeName := range r.aeroCluster.Status.ScaleDownSelection.PodK8sNodes {
+			nodes.Insert(nodeName)
+		}
+	}
+
+	return nodes.List()
+}
+
+// isPodOnAvoidedK8sNode returns true if the pod has to be migrated to a different Kubernetes node. It is the case
+// if the pod is on a k8sNodeBlockList node, on a node selected for scale-down or if the pod is selected for
+// scale-down and is still on the node it was selected on. Other pods sharing the node of a selected pod are not
+// migrated.
+func (r *SingleClusterReconciler) isPodOnAvoidedK8sNode(pod *corev1.Pod) bool {
+	nodeName := pod.Spec.NodeName
+	if nodeName == "" {
+		return false
+	}
+
+	if sets.NewString(r.aeroCluster.Spec.K8sNodeBlockList...).Has(nodeName) {
+		return true
+	}
+
+	if r.aeroCluster.Spec.ScaleDownSelection != nil &&
+		sets.NewString(r.aeroCluster.Spec.ScaleDownSelection.K8sNodeNames...).Has(nodeName) {
+		return true
+	}
+
+	return r.isPodSelectedForScaleDown(pod)
+}
+
+// isPodSelectedForScaleDown returns true if the pod is selected in spec.scaleDownSelection.podNames and is still on
+// the Kubernetes node it was selected on, i.e. it has not been replaced yet.
+func (r *SingleClusterReconciler) isPodSelectedForScaleDown(pod *corev1.Pod) bool {
+	return pod.Spec.NodeName != "" && r.aeroCluster.Status.ScaleDownSelection != nil &&
+		r.aeroCluster.Status.ScaleDownSelection.PodK8sNodes[pod.Name] == pod.Spec.NodeName
+}
+
+// deleteAvoidedK8sNodePodPVCs deletes the PVCs of a pod which is migrated away from an avoided Kubernetes node. All
+// the PVCs of a pod selected for scale-down are deleted, so the selected Aerospike node and its data leave the
+// cluster and the StatefulSet recreates the pod as an empty node on another Kubernetes node. A running pod is
+// deleted only after it is quiesced and the migrations are complete. Only the local PVCs of other pods are deleted.
+func (r *SingleClusterReconciler) deleteAvoidedK8sNodePodPVCs(rackState *RackState, pod *corev1.Pod) error {
+	if !r.isPodSelectedForScaleDown(pod) {
+		return r.deleteLocalPVCs(rackState, pod)
+	}
+
+	pvcItems, err := r.getPodsPVCList([]string{pod.Name}, rackState.Rack.ID)
+	if err != nil {
+		return fmt.Errorf("could not find pvc for pod %v: %v", pod.Name, err)
+	}
+
+	for idx := range pvcItems {
+		if err := r.Client.Delete(context.TODO(), &pvcItems[idx]); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("could not delete pvc %s: %v", pvcItems[idx].Name, err)
+		}
+	}
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "ScaleDownSelectedPodReplaced",
+		"[rack-%d] Deleted all PVCs of Pod %s selected for scale-down", rackState.Rack.ID, pod.Name,
+	)
+
+	return nil
+}
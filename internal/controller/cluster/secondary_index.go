@@ -0,0 +1,389 @@
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	as "github.com/aerospike/aerospike-client-go/v7"
+	"github.com/aerospike/aerospike-client-go/v7/types"
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-management-lib/deployment"
+)
+
+const (
+	infoCmdSindexList = "sindex-list:"
+
+	// serverIndexNull is reported by sindex-list for the set and the context of an index which doesn't have them.
+	serverIndexNull = "NULL"
+
+	// secondaryIndexRefreshPeriod is the period at which the build progress of the secondary indexes is refreshed
+	// while they are being built.
+	secondaryIndexRefreshPeriod = 10 * time.Second
+)
+
+// reconcileSecondaryIndexes creates the secondary indexes of the spec missing in the server, and drops the indexes
+// removed from the spec. Only the indexes tracked in status are dropped.
+// Returns true if any index is still being built.
+func (r *SingleClusterReconciler) reconcileSecondaryIndexes(allHostConns []*deployment.HostConn) (bool, error) {
+	specIndexes := r.aeroCluster.Spec.SecondaryIndexes
+	statusIndexes := r.aeroCluster.Status.SecondaryIndexes
+
+	if len(specIndexes) == 0 && len(statusIndexes) == 0 {
+		return false, nil
+	}
+
+	policy := r.getClientPolicy()
+
+	serverIndexes, err := getServerSecondaryIndexes(policy, allHostConns)
+	if err != nil {
+		return false, err
+	}
+
+	aeroClient, err := r.newAerospikeClient(allHostConns)
+	if err != nil {
+		return false, err
+	}
+
+	defer aeroClient.Close()
+
+	writePolicy := as.NewWritePolicy(0, 0)
+
+	// Drop the indexes removed from the spec.
+	for idx := range statusIndexes {
+		statusIndex := &statusIndexes[idx]
+		if getSecondaryIndexSpec(specIndexes, statusIndex.Namespace, statusIndex.Name) != nil {
+			continue
+		}
+
+		if _, ok := serverIndexes[secondaryIndexKey(statusIndex.Namespace, statusIndex.Name)]; !ok {
+			continue
+		}
+
+		if dErr := aeroClient.DropIndex(
+			writePolicy, statusIndex.Namespace, statusIndex.Set, statusIndex.Name,
+		); dErr != nil && !dErr.Matches(types.INDEX_NOTFOUND) {
+			return false, fmt.Errorf("failed to drop secondary index %s: %v", statusIndex.Name, dErr)
+		}
+
+		r.Log.Info("Dropped secondary index", "namespace", statusIndex.Namespace, "name", statusIndex.Name)
+		r.Recorder.Eventf(
+			r.aeroCluster, corev1.EventTypeNormal, "SecondaryIndexDropped",
+			"Dropped secondary index %s in namespace %s", statusIndex.Name, statusIndex.Namespace,
+		)
+	}
+
+	var (
+		newStatusIndexes []asdbv1.SecondaryIndexStatus
+		indexErrs        []string
+		building         bool
+	)
+
+	for idx := range specIndexes {
+		sindex := &specIndexes[idx]
+		indexStatus := asdbv1.SecondaryIndexStatus{
+			Name:      sindex.Name,
+			Namespace: sindex.Namespace,
+			Set:       sindex.Set,
+			Bin:       sindex.Bin,
+			Phase:     asdbv1.SecondaryIndexBuilding,
+		}
+
+		if cErr := r.createOrRecreateSecondaryIndex(
+			aeroClient, writePolicy, sindex, serverIndexes[secondaryIndexKey(sindex.Namespace, sindex.Name)],
+		); cErr != nil {
+			indexStatus.Phase = asdbv1.SecondaryIndexFailed
+			indexStatus.Message = cErr.Error()
+		}
+
+		if indexStatus.Phase == asdbv1.SecondaryIndexFailed {
+			indexErrs = append(indexErrs, fmt.Sprintf("%s: %s", sindex.Name, indexStatus.Message))
+		} else {
+			indexStatus.LoadPct = getSecondaryIndexLoadPct(policy, allHostConns, sindex)
+			if indexStatus.LoadPct >= 100 {
+				indexStatus.Phase = asdbv1.SecondaryIndexReady
+			} else {
+				building = true
+			}
+		}
+
+		newStatusIndexes = append(newStatusIndexes, indexStatus)
+	}
+
+	if uErr := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.SecondaryIndexes = newStatusIndexes
+	}); uErr != nil {
+		return building, fmt.Errorf("failed to update secondary indexes in status: %v", uErr)
+	}
+
+	if len(indexErrs) != 0 {
+		return building, fmt.Errorf("failed to create secondary indexes: %s", strings.Join(indexErrs, ", "))
+	}
+
+	return building, nil
+}
+
+// createOrRecreateSecondaryIndex creates the index if it is missing in the server. If the index exists in the server
+// with a different definition, it is dropped and created again with the definition of the spec.
+func (r *SingleClusterReconciler) createOrRecreateSecondaryIndex(
+	aeroClient *as.Client, writePolicy *as.WritePolicy, sindex *asdbv1.SecondaryIndexSpec,
+	serverIndex map[string]string,
+) error {
+	cdtCtx, err := getCDTContext(sindex.Context)
+	if err != nil {
+		return err
+	}
+
+	if serverIndex != nil {
+		isSame, sErr := isSameSecondaryIndex(sindex, cdtCtx, serverIndex)
+		if sErr != nil {
+			return sErr
+		}
+
+		if isSame {
+			return nil
+		}
+
+		r.Log.Info(
+			"Secondary index exists in the server with a different definition, recreating it",
+			"namespace", sindex.Namespace, "name", sindex.Name, "serverIndex", serverIndex,
+		)
+
+		if dErr := aeroClient.DropIndex(
+			writePolicy, sindex.Namespace, getServerIndexSet(serverIndex), sindex.Name,
+		); dErr != nil && !dErr.Matches(types.INDEX_NOTFOUND) {
+			return fmt.Errorf("failed to drop secondary index %s to recreate it: %v", sindex.Name, dErr)
+		}
+
+		r.Recorder.Eventf(
+			r.aeroCluster, corev1.EventTypeNormal, "SecondaryIndexDropped",
+			"Dropped secondary index %s in namespace %s to recreate it with a different definition",
+			sindex.Name, sindex.Namespace,
+		)
+	}
+
+	if _, cErr := aeroClient.CreateComplexIndex(
+		writePolicy, sindex.Namespace, sindex.Set, sindex.Name, sindex.Bin,
+		getIndexType(sindex.Type), getIndexCollectionType(sindex.CollectionType), cdtCtx...,
+	); cErr != nil && !cErr.Matches(types.INDEX_FOUND) {
+		return cErr
+	}
+
+	r.Log.Info("Created secondary index", "namespace", sindex.Namespace, "name", sindex.Name)
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "SecondaryIndexCreated",
+		"Created secondary index %s in namespace %s", sindex.Name, sindex.Namespace,
+	)
+
+	return nil
+}
+
+// getServerSecondaryIndexes returns the secondary indexes in the server keyed by namespace and index name.
+// Index definitions are shared by all the nodes, so they are read from the first node that responds.
+func getServerSecondaryIndexes(
+	policy *as.ClientPolicy, allHostConns []*deployment.HostConn,
+) (map[string]map[string]string, error) {
+	var infoErrs []string
+
+	for _, hostConn := range allHostConns {
+		res, err := hostConn.ASConn.RunInfo(policy, infoCmdSindexList)
+		if err != nil {
+			infoErrs = append(infoErrs, fmt.Sprintf("node %s: %v", hostConn.ID, err))
+			continue
+		}
+
+		return parseSecondaryIndexList(res[infoCmdSindexList]), nil
+	}
+
+	return nil, fmt.Errorf("failed to list secondary indexes: %s", strings.Join(infoErrs, ", "))
+}
+
+// parseSecondaryIndexList parses the sindex-list info response, e.g.
+// ns=test:indexname=idx1:set=demo:bin=age:type=numeric:indextype=default:context=NULL:state=RW;...
+func parseSecondaryIndexList(res string) map[string]map[string]string {
+	indexes := make(map[string]map[string]string)
+
+	for _, indexInfo := range strings.Split(res, ";") {
+		if indexInfo == "" {
+			continue
+		}
+
+		index := make(map[string]string)
+
+		for _, field := range strings.Split(indexInfo, ":") {
+			if key, value, ok := strings.Cut(field, "="); ok {
+				index[key] = value
+			}
+		}
+
+		indexes[secondaryIndexKey(index["ns"], index["indexname"])] = index
+	}
+
+	return indexes
+}
+
+// getSecondaryIndexLoadPct returns the lowest build progress of the index across the nodes.
+// Nodes which don't report the index yet are considered at 0 percent.
+func getSecondaryIndexLoadPct(
+	policy *as.ClientPolicy, allHostConns []*deployment.HostConn, sindex *asdbv1.SecondaryIndexSpec,
+) int32 {
+	cmd := fmt.Sprintf("sindex-stat:namespace=%s;indexname=%s", sindex.Namespace, sindex.Name)
+	loadPct := int32(100)
+
+	for _, hostConn := range allHostConns {
+		res, err := hostConn.ASConn.RunInfo(policy, cmd)
+		if err != nil {
+			return 0
+		}
+
+		stats, err := deployment.ParseInfoIntoMap(res[cmd], ";", "=")
+		if err != nil {
+			return 0
+		}
+
+		nodeLoadPct, err := strconv.ParseInt(stats["load_pct"], 10, 32)
+		if err != nil {
+			return 0
+		}
+
+		loadPct = min(loadPct, int32(nodeLoadPct))
+	}
+
+	return loadPct
+}
+
+// isSameSecondaryIndex returns true if the index in the server has the definition given in the spec, i.e. the same
+// set, bin, type, collection type (indextype) and CDT context. The server reports the context base64 encoded.
+func isSameSecondaryIndex(
+	sindex *asdbv1.SecondaryIndexSpec, cdtCtx []*as.CDTContext, serverIndex map[string]string,
+) (bool, error) {
+	collectionType := sindex.CollectionType
+	if collectionType == "" {
+		collectionType = asdbv1.SecondaryIndexCollectionTypeDefault
+	}
+
+	indexCtx := serverIndexNull
+
+	if len(cdtCtx) != 0 {
+		var err error
+
+		indexCtx, err = as.CDTContextToBase64(cdtCtx)
+		if err != nil {
+			return false, fmt.Errorf("failed to encode context of secondary index %s: %v", sindex.Name, err)
+		}
+	}
+
+	// Servers older than 6.1 don't report the context.
+	serverCtx, ok := serverIndex["context"]
+	if !ok {
+		serverCtx = serverIndexNull
+	}
+
+	return serverIndex["bin"] == sindex.Bin && getServerIndexSet(serverIndex) == sindex.Set &&
+		strings.EqualFold(serverIndex["type"], string(sindex.Type)) &&
+		strings.EqualFold(serverIndex["indextype"], string(collectionType)) &&
+		serverCtx == indexCtx, nil
+}
+
+// getServerIndexSet returns the set of the index in the server, which is empty if the index is on all the sets.
+func getServerIndexSet(serverIndex map[string]string) string {
+	if serverIndex["set"] == serverIndexNull {
+		return ""
+	}
+
+	return serverIndex["set"]
+}
+
+func isSecondaryIndexBuilding(statusIndexes []asdbv1.SecondaryIndexStatus) bool {
+	for idx := range statusIndexes {
+		if statusIndexes[idx].Phase == asdbv1.SecondaryIndexBuilding {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getSecondaryIndexSpec(
+	specIndexes []asdbv1.SecondaryIndexSpec, namespace, name string,
+) *asdbv1.SecondaryIndexSpec {
+	for idx := range specIndexes {
+		if specIndexes[idx].Namespace == namespace && specIndexes[idx].Name == name {
+			return &specIndexes[idx]
+		}
+	}
+
+	return nil
+}
+
+func secondaryIndexKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+func getIndexType(indexType asdbv1.SecondaryIndexType) as.IndexType {
+	switch indexType {
+	case asdbv1.SecondaryIndexTypeString:
+		return as.STRING
+	case asdbv1.SecondaryIndexTypeGeo2DSphere:
+		return as.GEO2DSPHERE
+	case asdbv1.SecondaryIndexTypeNumeric:
+	}
+
+	return as.NUMERIC
+}
+
+func getIndexCollectionType(collectionType asdbv1.SecondaryIndexCollectionType) as.IndexCollectionType {
+	switch collectionType {
+	case asdbv1.SecondaryIndexCollectionTypeList:
+		return as.ICT_LIST
+	case asdbv1.SecondaryIndexCollectionTypeMapKeys:
+		return as.ICT_MAPKEYS
+	case asdbv1.SecondaryIndexCollectionTypeMapValues:
+		return as.ICT_MAPVALUES
+	case asdbv1.SecondaryIndexCollectionTypeDefault:
+	}
+
+	return as.ICT_DEFAULT
+}
+
+// getCDTContext converts the context of the spec to the client CDT context.
+func getCDTContext(contextSpec []asdbv1.CDTContextSpec) ([]*as.CDTContext, error) {
+	cdtCtx := make([]*as.CDTContext, 0, len(contextSpec))
+
+	for idx := range contextSpec {
+		value := contextSpec[idx].Value
+
+		var ctxValue as.Value
+
+		if value.Type == intstr.Int {
+			ctxValue = as.NewValue(int(value.IntVal))
+		} else {
+			ctxValue = as.NewValue(value.StrVal)
+		}
+
+		switch contextSpec[idx].Type {
+		case asdbv1.CDTContextTypeListIndex:
+			cdtCtx = append(cdtCtx, as.CtxListIndex(value.IntValue()))
+		case asdbv1.CDTContextTypeListRank:
+			cdtCtx = append(cdtCtx, as.CtxListRank(value.IntValue()))
+		case asdbv1.CDTContextTypeListValue:
+			cdtCtx = append(cdtCtx, as.CtxListValue(ctxValue))
+		case asdbv1.CDTContextTypeMapIndex:
+			cdtCtx = append(cdtCtx, as.CtxMapIndex(value.IntValue()))
+		case asdbv1.CDTContextTypeMapRank:
+			cdtCtx = append(cdtCtx, as.CtxMapRank(value.IntValue()))
+		case asdbv1.CDTContextTypeMapKey:
+			cdtCtx = append(cdtCtx, as.CtxMapKey(ctxValue))
+		case asdbv1.CDTContextTypeMapValue:
+			cdtCtx = append(cdtCtx, as.CtxMapValue(ctxValue))
+		default:
+			return nil, fmt.Errorf("invalid CDT context type %s", contextSpec[idx].Type)
+		}
+	}
+
+	return cdtCtx, nil
+}
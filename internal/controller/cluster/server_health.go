@@ -0,0 +1,436 @@
+package cluster
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+	"github.com/aerospike/aerospike-management-lib/deployment"
+)
+
+const (
+	infoCmdStatistics = "statistics"
+	infoCmdNamespaces = "namespaces"
+)
+
+// serverHealthRefreshPeriod returns the period of the server health snapshot refresh.
+func (r *SingleClusterReconciler) serverHealthRefreshPeriod() time.Duration {
+	if r.aeroCluster.Spec.ServerHealth.RefreshPeriod.Duration < asdbv1.MinServerHealthRefreshPeriod {
+		return asdbv1.DefaultServerHealthRefreshPeriod
+	}
+
+	return r.aeroCluster.Spec.ServerHealth.RefreshPeriod.Duration
+}
+
+// isServerHealthRefreshOnly returns true if this reconcile is the requeue for the server health refresh, and the
+// last reconcile of the current spec has completed successfully with all the pods of the cluster ready.
+// Any other reconcile request, e.g. for a deleted statefulset, goes through the full reconcile.
+func (r *SingleClusterReconciler) isServerHealthRefreshOnly() bool {
+	status := &r.aeroCluster.Status
+
+	if r.aeroCluster.Spec.ServerHealth == nil ||
+		r.aeroCluster.Labels[asdbv1.AerospikeAPIVersionLabel] != asdbv1.AerospikeAPIVersion ||
+		status.Phase != asdbv1.AerospikeClusterCompleted ||
+		status.ObservedGeneration != r.aeroCluster.Generation ||
+		status.LastReconcileError != "" ||
+		status.LastSuccessfulReconcileTime == nil ||
+		status.ServerHealth == nil ||
+		isSecondaryIndexBuilding(status.SecondaryIndexes) ||
+		len(status.Pods) != int(r.aeroCluster.Spec.Size) {
+		return false
+	}
+
+	if !r.isServerHealthRefreshDue() {
+		return false
+	}
+
+	ready, err := r.areAllSTSReady()
+	if err != nil {
+		r.Log.Error(err, "Failed to check the statefulsets, running the full reconcile")
+		return false
+	}
+
+	if !ready {
+		return false
+	}
+
+	// Consume the requeue so that only one reconcile takes the refresh only path for it.
+	r.serverHealthRefreshes.Delete(utils.GetNamespacedName(r.aeroCluster))
+
+	return true
+}
+
+// isServerHealthRefreshDue returns true if the server health refresh requeued for the cluster is due.
+func (r *SingleClusterReconciler) isServerHealthRefreshDue() bool {
+	if r.serverHealthRefreshes == nil {
+		return false
+	}
+
+	refreshTime, ok := r.serverHealthRefreshes.Load(utils.GetNamespacedName(r.aeroCluster))
+
+	return ok && refreshTime.(time.Time).Before(time.Now().Add(time.Second))
+}
+
+// areAllSTSReady returns true if the statefulsets of the cluster have all their pods updated and ready, and they
+// have as many pods as the cluster size.
+func (r *SingleClusterReconciler) areAllSTSReady() (bool, error) {
+	stsList, err := r.getClusterSTSList()
+	if err != nil {
+		return false, err
+	}
+
+	var replicas int32
+
+	for idx := range stsList.Items {
+		sts := &stsList.Items[idx]
+		if sts.Spec.Replicas == nil || sts.Status.ObservedGeneration != sts.Generation ||
+			sts.Status.ReadyReplicas != *sts.Spec.Replicas || sts.Status.UpdatedReplicas != *sts.Spec.Replicas {
+			return false, nil
+		}
+
+		replicas += *sts.Spec.Replicas
+	}
+
+	return replicas == r.aeroCluster.Spec.Size, nil
+}
+
+// refreshServerHealth takes a new server health snapshot, autoscales the cluster based on it unless the cluster is
+// paused, and requeues the reconcile for the next refresh.
+// Failure to take the snapshot is recorded in the snapshot itself and does not fail the reconcile.
+func (r *SingleClusterReconciler) refreshServerHealth() common.ReconcileResult {
+	if r.aeroCluster.Spec.ServerHealth == nil {
+		if r.serverHealthRefreshes != nil {
+			r.serverHealthRefreshes.Delete(utils.GetNamespacedName(r.aeroCluster))
+		}
+
+		if r.aeroCluster.Status.ServerHealth == nil {
+			return common.ReconcileSuccess()
+		}
+
+		// Server health is disabled, remove the stale snapshot.
+		if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+			status.ServerHealth = nil
+		}); err != nil {
+			return common.ReconcileError(fmt.Errorf("failed to remove server health from status: %v", err))
+		}
+
+		return common.ReconcileSuccess()
+	}
+
+	serverHealth, err := r.getServerHealth()
+	if err != nil {
+		r.Log.Error(err, "Failed to get server health")
+
+		serverHealth.Error = err.Error()
+	}
+
+	if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.ServerHealth = serverHealth
+	}); err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to update server health in status: %v", err))
+	}
+
+	// Scale the cluster based on the new snapshot. A paused cluster is not scaled.
+	if !asdbv1.GetBool(r.aeroCluster.Spec.Paused) {
+		if err := r.reconcileAutoscaling(serverHealth); err != nil {
+			return common.ReconcileError(fmt.Errorf("failed to autoscale: %v", err))
+		}
+	}
+
+	// Record the requeue, so that it can take the refresh only path.
+	if r.serverHealthRefreshes != nil {
+		r.serverHealthRefreshes.Store(
+			utils.GetNamespacedName(r.aeroCluster), time.Now().Add(r.serverHealthRefreshPeriod()),
+		)
+	}
+
+	return common.ReconcileResult{
+		IsSuccess: true, Result: reconcile.Result{RequeueAfter: r.serverHealthRefreshPeriod()},
+	}
+}
+
+// getServerHealth takes a health snapshot of the Aerospike server using info calls on all the running and ready
+// pods. A partial snapshot is returned along with the error if any info call fails.
+func (r *SingleClusterReconciler) getServerHealth() (*asdbv1.ServerHealthStatus, error) {
+	serverHealth := &asdbv1.ServerHealthStatus{
+		LastRefreshTime: metav1.Now(),
+	}
+
+	podList, err := r.getClusterPodList()
+	if err != nil {
+		return serverHealth, err
+	}
+
+	policy := r.getClientPolicy()
+	clusterKeys := make(map[string]struct{})
+	clusterSizes := make(map[int64]struct{})
+	nsHealthMap := make(map[string]*asdbv1.NamespaceHealthStatus)
+	specNamespaces := r.getSpecNamespaceConfigs()
+	xdrDCNames := getXDRDCNames(r.aeroCluster.Spec.AerospikeConfig)
+
+	if len(xdrDCNames) != 0 {
+		serverHealth.XDRDCs = make([]asdbv1.XDRDCHealthStatus, len(xdrDCNames))
+	}
+
+	var infoErrs []string
+
+	for idx := range podList.Items {
+		pod := &podList.Items[idx]
+		if !utils.IsPodRunningAndReady(pod) {
+			continue
+		}
+
+		asConn := r.newAsConn(pod)
+
+		res, err := asConn.RunInfo(policy, infoCmdStatistics, infoCmdNamespaces)
+		if err != nil {
+			infoErrs = append(infoErrs, fmt.Sprintf("pod %s: %v", pod.Name, err))
+			continue
+		}
+
+		stats, err := deployment.ParseInfoIntoMap(res[infoCmdStatistics], ";", "=")
+		if err != nil {
+			infoErrs = append(infoErrs, fmt.Sprintf("pod %s: %v", pod.Name, err))
+			continue
+		}
+
+		clusterKeys[stats["cluster_key"]] = struct{}{}
+		clusterSizes[parseInfoInt(stats["cluster_size"])] = struct{}{}
+
+		var nsCmds []string
+
+		for _, ns := range strings.Split(res[infoCmdNamespaces], ";") {
+			if ns != "" {
+				nsCmds = append(nsCmds, "namespace/"+ns)
+			}
+		}
+
+		xdrCmds := make([]string, 0, len(xdrDCNames))
+		for _, dcName := range xdrDCNames {
+			xdrCmds = append(xdrCmds, getXDRDCStatsCmd(dcName))
+		}
+
+		if len(nsCmds) == 0 && len(xdrCmds) == 0 {
+			serverHealth.NodesResponded++
+			continue
+		}
+
+		nsRes, err := asConn.RunInfo(policy, append(nsCmds, xdrCmds...)...)
+		if err != nil {
+			infoErrs = append(infoErrs, fmt.Sprintf("pod %s: %v", pod.Name, err))
+			continue
+		}
+
+		serverHealth.NodesResponded++
+
+		for _, nsCmd := range nsCmds {
+			nsStats, err := deployment.ParseInfoIntoMap(nsRes[nsCmd], ";", "=")
+			if err != nil {
+				infoErrs = append(infoErrs, fmt.Sprintf("pod %s: %v", pod.Name, err))
+				continue
+			}
+
+			nsName := strings.TrimPrefix(nsCmd, "namespace/")
+
+			nsHealth, ok := nsHealthMap[nsName]
+			if !ok {
+				nsHealth = &asdbv1.NamespaceHealthStatus{
+					Name:              nsName,
+					StrongConsistency: asdbv1.IsNSSCEnabled(specNamespaces[nsName]),
+				}
+				nsHealthMap[nsName] = nsHealth
+			}
+
+			serverHealth.MigrateTxPartitionsRemaining += parseInfoInt(nsStats["migrate_tx_partitions_remaining"])
+			serverHealth.MigrateRxPartitionsRemaining += parseInfoInt(nsStats["migrate_rx_partitions_remaining"])
+
+			updateNamespaceHealth(nsHealth, nsStats, specNamespaces[nsName])
+		}
+
+		for idx, dcName := range xdrDCNames {
+			dcStats, err := deployment.ParseInfoIntoMap(nsRes[xdrCmds[idx]], ";", "=")
+			if err != nil {
+				infoErrs = append(infoErrs, fmt.Sprintf("pod %s: %v", pod.Name, err))
+				continue
+			}
+
+			dcHealth := &serverHealth.XDRDCs[idx]
+			dcHealth.Name = dcName
+			dcHealth.Lag = max(dcHealth.Lag, parseInfoInt(dcStats["lag"]))
+			dcHealth.InQueue += parseInfoInt(dcStats["in_queue"])
+			dcHealth.RecoveriesPending += parseInfoInt(dcStats["recoveries_pending"])
+		}
+	}
+
+	for size := range clusterSizes {
+		if serverHealth.ClusterSize == 0 || int32(size) < serverHealth.ClusterSize {
+			serverHealth.ClusterSize = int32(size)
+		}
+	}
+
+	serverHealth.ClusterKeyAgreement = serverHealth.NodesResponded > 0 && len(clusterKeys) == 1 &&
+		len(clusterSizes) == 1
+
+	for _, nsHealth := range nsHealthMap {
+		serverHealth.Namespaces = append(serverHealth.Namespaces, *nsHealth)
+	}
+
+	sort.Slice(
+		serverHealth.Namespaces, func(i, j int) bool {
+			return serverHealth.Namespaces[i].Name < serverHealth.Namespaces[j].Name
+		},
+	)
+
+	if len(infoErrs) != 0 {
+		return serverHealth, fmt.Errorf("failed to get info from pods: %s", strings.Join(infoErrs, ", "))
+	}
+
+	return serverHealth, nil
+}
+
+// getSpecNamespaceConfigs returns the namespace configs from the spec keyed by namespace name.
+// SC and storage-engine settings are the same across racks, so the first rack is looked up.
+func (r *SingleClusterReconciler) getSpecNamespaceConfigs() map[string]map[string]interface{} {
+	nsConfigs := make(map[string]map[string]interface{})
+
+	if len(r.aeroCluster.Spec.RackConfig.Racks) == 0 {
+		return nsConfigs
+	}
+
+	nsList, ok := r.aeroCluster.Spec.RackConfig.Racks[0].AerospikeConfig.Value["namespaces"].([]interface{})
+	if !ok {
+		return nsConfigs
+	}
+
+	for _, nsConfInterface := range nsList {
+		nsConf, ok := nsConfInterface.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := nsConf["name"].(string); ok {
+			nsConfigs[name] = nsConf
+		}
+	}
+
+	return nsConfigs
+}
+
+// getXDRDCNames returns the names of the XDR DCs configured in the aerospikeConfig.
+func getXDRDCNames(aeroConfig *asdbv1.AerospikeConfigSpec) []string {
+	if aeroConfig == nil {
+		return nil
+	}
+
+	xdrConf, ok := aeroConfig.Value["xdr"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	dcs, ok := xdrConf["dcs"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	dcNames := make([]string, 0, len(dcs))
+
+	for _, dcConfInterface := range dcs {
+		if dcConf, ok := dcConfInterface.(map[string]interface{}); ok {
+			if name, ok := dcConf["name"].(string); ok {
+				dcNames = append(dcNames, name)
+			}
+		}
+	}
+
+	return dcNames
+}
+
+func getXDRDCStatsCmd(dcName string) string {
+	return fmt.Sprintf("get-stats:context=xdr;dc=%s", dcName)
+}
+
+// updateNamespaceHealth merges the namespace stats of a node into the namespace health.
+// Partition counts are cluster wide, usage is the highest across nodes and data used is the total across nodes.
+func updateNamespaceHealth(
+	nsHealth *asdbv1.NamespaceHealthStatus, nsStats map[string]string, nsConf map[string]interface{},
+) {
+	if nsHealth.StrongConsistency {
+		nsHealth.UnavailablePartitions = max(
+			nsHealth.UnavailablePartitions, parseInfoInt(nsStats["unavailable_partitions"]),
+		)
+		nsHealth.DeadPartitions = max(nsHealth.DeadPartitions, parseInfoInt(nsStats["dead_partitions"]))
+	}
+
+	// Server versions before 7.0 report free percentage for memory and device.
+	if freePct, ok := nsStats["memory_free_pct"]; ok {
+		nsHealth.MemoryUsedPct = maxUsedPct(nsHealth.MemoryUsedPct, 100-parseInfoFloat(freePct))
+	}
+
+	if freePct, ok := nsStats["device_free_pct"]; ok {
+		nsHealth.DeviceUsedPct = maxUsedPct(nsHealth.DeviceUsedPct, 100-parseInfoFloat(freePct))
+	}
+
+	// Server versions 7.0 and later report data usage for the configured storage-engine.
+	if usedPct, ok := nsStats["data_used_pct"]; ok {
+		if getStorageEngineType(nsConf) == "memory" {
+			nsHealth.MemoryUsedPct = maxUsedPct(nsHealth.MemoryUsedPct, parseInfoFloat(usedPct))
+		} else {
+			nsHealth.DeviceUsedPct = maxUsedPct(nsHealth.DeviceUsedPct, parseInfoFloat(usedPct))
+		}
+	}
+
+	// Primary index usage is reported for the flash and pmem index types.
+	for _, key := range []string{"index_flash_used_pct", "index_pmem_used_pct", "index_mounts_used_pct"} {
+		if usedPct, ok := nsStats[key]; ok {
+			nsHealth.IndexUsedPct = maxUsedPct(nsHealth.IndexUsedPct, parseInfoFloat(usedPct))
+		}
+	}
+
+	switch {
+	case nsStats["data_used_bytes"] != "":
+		nsHealth.DataUsedBytes += parseInfoInt(nsStats["data_used_bytes"])
+	case getStorageEngineType(nsConf) == "memory":
+		nsHealth.DataUsedBytes += parseInfoInt(nsStats["memory_used_bytes"])
+	default:
+		nsHealth.DataUsedBytes += parseInfoInt(nsStats["device_used_bytes"])
+	}
+}
+
+func getStorageEngineType(nsConf map[string]interface{}) string {
+	storage, ok := nsConf["storage-engine"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	storageType, _ := storage["type"].(string)
+
+	return storageType
+}
+
+func maxUsedPct(current *int32, usedPct float64) *int32 {
+	pct := int32(math.Ceil(usedPct))
+	if current != nil && *current >= pct {
+		return current
+	}
+
+	return &pct
+}
+
+func parseInfoInt(value string) int64 {
+	intValue, _ := strconv.ParseInt(value, 10, 64)
+	return intValue
+}
+
+func parseInfoFloat(value string) float64 {
+	floatValue, _ := strconv.ParseFloat(value, 64)
+	return floatValue
+}
@@ -32,11 +32,6 @@ const (
 	// Need to modify this name if prefix is changed in yaml file
 	aeroClusterServiceAccountName string = "aerospike-operator-controller-manager"
 
-	// This storage path annotation is added in pvc to make reverse association with storage.volume.path
-	// while deleting pvc
-	storageVolumeAnnotationKey       = "storage-volume"
-	storageVolumeLegacyAnnotationKey = "storage-path"
-
 	confDirName                = "confdir"
 	initConfDirName            = "initconfigs"
 	podServiceAccountMountPath = "/var/run/secrets/kubernetes.io/serviceaccount"
@@ -187,6 +182,10 @@ func (r *SingleClusterReconciler) createSTS(
 		return nil, err
 	}
 
+	if err := r.createPVCsFromVolumeSnapshots(st, rackState); err != nil {
+		return nil, fmt.Errorf("failed to restore PVCs from volume snapshots: %v", err)
+	}
+
 	if err := r.Client.Create(context.TODO(), st, common.CreateOption); err != nil {
 		return nil, fmt.Errorf("failed to create new StatefulSet: %v", err)
 	}
@@ -928,12 +927,12 @@ func (r *SingleClusterReconciler) updateSTSSchedulingPolicy(
 		)
 	}
 
-	if len(r.aeroCluster.Spec.K8sNodeBlockList) > 0 {
+	if avoidedK8sNodes := r.getAvoidedK8sNodes(); len(avoidedK8sNodes) > 0 {
 		matchExpressions = append(
 			matchExpressions, corev1.NodeSelectorRequirement{
 				Key:      "kubernetes.io/hostname",
 				Operator: corev1.NodeSelectorOpNotIn,
-				Values:   r.aeroCluster.Spec.K8sNodeBlockList,
+				Values:   avoidedK8sNodes,
 			},
 		)
 	}
@@ -1401,7 +1400,7 @@ func createPVCForVolumeAttachment(
 	}
 
 	// Use this path annotation while matching pvc with storage volume
-	newAnnotations := map[string]string{storageVolumeAnnotationKey: volume.Name}
+	newAnnotations := map[string]string{utils.StorageVolumeAnnotationKey: volume.Name}
 	for k, v := range pv.Annotations {
 		newAnnotations[k] = v
 	}
@@ -1436,6 +1435,10 @@ func createVolumeForVolumeAttachment(volume *asdbv1.VolumeSpec) corev1.Volume {
 			ConfigMap: volume.Source.ConfigMap,
 			Secret:    volume.Source.Secret,
 			EmptyDir:  volume.Source.EmptyDir,
+			Projected: volume.Source.Projected,
+			CSI:       volume.Source.CSI,
+			Ephemeral: volume.Source.Ephemeral,
+			HostPath:  volume.Source.HostPath,
 		},
 	}
 }
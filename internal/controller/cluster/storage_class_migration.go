@@ -0,0 +1,264 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+// storageClassMigrationRefreshPeriod is the period in seconds at which the next pod of the storage class migration
+// is checked.
+const storageClassMigrationRefreshPeriod = 10
+
+// reconcileStorageClassMigration replaces the PVCs of the rack pods whose storage class differs from the storage
+// class of their volume, if spec.storageClassMigration is set. The PVCs of a single pod are replaced at a time, only
+// when all the pods are ready and no migrations are pending. The pod and its old PVCs are deleted, and the
+// StatefulSet recreates them from its volumeClaimTemplates, which are updated by reconcileVolumeExpansion.
+// The new PVCs have a new UID, so they are initialized again with their initMethod by the init container.
+// It returns a requeue result while pods of the rack are pending migration.
+func (r *SingleClusterReconciler) reconcileStorageClassMigration(
+	rackState *RackState, ignorablePodNames sets.Set[string],
+) common.ReconcileResult {
+	migration := r.aeroCluster.Spec.StorageClassMigration
+	if migration == nil {
+		if r.aeroCluster.Status.StorageClassMigration == nil {
+			return common.ReconcileSuccess()
+		}
+
+		if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+			status.StorageClassMigration = nil
+		}); err != nil {
+			return common.ReconcileError(err)
+		}
+
+		return common.ReconcileSuccess()
+	}
+
+	podPVCs, err := r.getStorageClassMigrationPVCs(rackState)
+	if err != nil {
+		return common.ReconcileError(err)
+	}
+
+	pendingPodNames := make([]string, 0, len(podPVCs))
+	for podName := range podPVCs {
+		pendingPodNames = append(pendingPodNames, podName)
+	}
+
+	sort.Strings(pendingPodNames)
+
+	if err = r.updateStorageClassMigrationStatus(rackState, pendingPodNames, ""); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	if len(pendingPodNames) == 0 {
+		return common.ReconcileSuccess()
+	}
+
+	if migration.Paused {
+		r.Log.Info("Storage class migration is paused", "rackID", rackState.Rack.ID, "pendingPods", pendingPodNames)
+		return common.ReconcileSuccess()
+	}
+
+	var podName string
+
+	for _, pendingPodName := range pendingPodNames {
+		if !ignorablePodNames.Has(pendingPodName) {
+			podName = pendingPodName
+			break
+		}
+	}
+
+	if podName == "" {
+		return common.ReconcileSuccess()
+	}
+
+	if waitReason := r.getStorageClassMigrationWaitReason(ignorablePodNames); waitReason != "" {
+		r.Log.Info("Waiting to migrate the next pod to the new storage class", "reason", waitReason)
+		return common.ReconcileRequeueAfter(storageClassMigrationRefreshPeriod)
+	}
+
+	allowed, err := r.isDisruptionAllowed()
+	if err != nil {
+		return common.ReconcileError(err)
+	}
+
+	if !allowed {
+		r.deferPodRestarts(rackState, map[string]RestartType{podName: podRestart})
+		return common.ReconcileSuccess()
+	}
+
+	if res := r.migratePodStorageClass(rackState, podName, podPVCs[podName], ignorablePodNames); !res.IsSuccess {
+		return res
+	}
+
+	if err = r.updateStorageClassMigrationStatus(rackState, pendingPodNames, podName); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	return common.ReconcileRequeueAfter(storageClassMigrationRefreshPeriod)
+}
+
+// getStorageClassMigrationPVCs returns the PVCs of the rack whose storage class differs from the storage class of
+// their volume, keyed by pod name.
+func (r *SingleClusterReconciler) getStorageClassMigrationPVCs(
+	rackState *RackState,
+) (map[string][]corev1.PersistentVolumeClaim, error) {
+	pvcItems, err := r.getRackPVCList(rackState.Rack.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find pvc for rack %d: %v", rackState.Rack.ID, err)
+	}
+
+	podPVCs := make(map[string][]corev1.PersistentVolumeClaim)
+
+	for idx := range pvcItems {
+		pvc := &pvcItems[idx]
+		if utils.IsPVCTerminating(pvc) {
+			continue
+		}
+
+		pvcStorageVolName, ok := utils.GetPVCStorageVolumeName(pvc)
+		if !ok {
+			continue
+		}
+
+		volume := getPVCVolumeConfig(&rackState.Rack.Storage, pvcStorageVolName)
+		if volume == nil || volume.Source.PersistentVolume == nil || isPVCOfStorageClass(pvc, volume) {
+			continue
+		}
+
+		podName := strings.TrimPrefix(pvc.Name, pvcStorageVolName+"-")
+		podPVCs[podName] = append(podPVCs[podName], *pvc)
+	}
+
+	return podPVCs, nil
+}
+
+// getStorageClassMigrationWaitReason returns the reason to wait before migrating the next pod, it is empty if the
+// next pod can be migrated.
+func (r *SingleClusterReconciler) getStorageClassMigrationWaitReason(ignorablePodNames sets.Set[string]) string {
+	podList, err := r.getClusterPodList()
+	if err != nil {
+		return fmt.Sprintf("failed to list pods: %v", err)
+	}
+
+	if len(podList.Items) != int(r.aeroCluster.Spec.Size) {
+		return fmt.Sprintf("cluster has %d pods, expected %d", len(podList.Items), r.aeroCluster.Spec.Size)
+	}
+
+	for idx := range podList.Items {
+		pod := &podList.Items[idx]
+		if pod.DeletionTimestamp != nil {
+			return fmt.Sprintf("pod %s is terminating", pod.Name)
+		}
+
+		if !ignorablePodNames.Has(pod.Name) && !utils.IsPodRunningAndReady(pod) {
+			return fmt.Sprintf("pod %s is not ready", pod.Name)
+		}
+	}
+
+	serverHealth, err := r.getServerHealth()
+	if err != nil {
+		return fmt.Sprintf("failed to get server health: %v", err)
+	}
+
+	if remaining := serverHealth.MigrateTxPartitionsRemaining + serverHealth.MigrateRxPartitionsRemaining; remaining != 0 {
+		return fmt.Sprintf("%d partitions remaining to migrate", remaining)
+	}
+
+	return ""
+}
+
+// migratePodStorageClass deletes the pod and its PVCs of an old storage class, so that they are recreated by the
+// StatefulSet with the new storage class.
+func (r *SingleClusterReconciler) migratePodStorageClass(
+	rackState *RackState, podName string, pvcs []corev1.PersistentVolumeClaim, ignorablePodNames sets.Set[string],
+) common.ReconcileResult {
+	pod := &corev1.Pod{}
+	if err := r.Client.Get(
+		context.TODO(), types.NamespacedName{Name: podName, Namespace: r.aeroCluster.Namespace}, pod,
+	); err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to get pod %s: %v", podName, err))
+	}
+
+	if res := r.waitForMultipleNodesSafeStopReady([]*corev1.Pod{pod}, ignorablePodNames); !res.IsSuccess {
+		return res
+	}
+
+	r.Log.Info("Migrating pod to the new storage class", "podName", podName, "PVCs", len(pvcs))
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "StorageClassMigration",
+		"[rack-%d] Replacing %d PVCs of pod %s with the new storage class", rackState.Rack.ID, len(pvcs), podName,
+	)
+
+	// The PVCs are protected until the pod is deleted.
+	for idx := range pvcs {
+		if err := r.Client.Delete(context.TODO(), &pvcs[idx]); err != nil && !errors.IsNotFound(err) {
+			return common.ReconcileError(fmt.Errorf("could not delete pvc %s: %v", pvcs[idx].Name, err))
+		}
+
+		r.Log.Info("PVC removed", "PVC", pvcs[idx].Name)
+	}
+
+	if err := r.Client.Delete(context.TODO(), pod); err != nil && !errors.IsNotFound(err) {
+		return common.ReconcileError(fmt.Errorf("failed to delete pod %s: %v", podName, err))
+	}
+
+	r.Log.V(1).Info("Pod deleted", "podName", podName)
+
+	return common.ReconcileSuccess()
+}
+
+// updateStorageClassMigrationStatus replaces the pending pods of the rack in the status. The current pod is updated
+// only if it is given.
+func (r *SingleClusterReconciler) updateStorageClassMigrationStatus(
+	rackState *RackState, rackPendingPodNames []string, currentPodName string,
+) error {
+	stsName := utils.GetNamespacedNameForSTSOrConfigMap(r.aeroCluster, rackState.Rack.ID).Name
+	migrationStatus := &asdbv1.StorageClassMigrationStatus{}
+
+	if r.aeroCluster.Status.StorageClassMigration != nil {
+		migrationStatus.CurrentPod = r.aeroCluster.Status.StorageClassMigration.CurrentPod
+
+		for _, podName := range r.aeroCluster.Status.StorageClassMigration.PendingPods {
+			if !isPodOfSTS(podName, stsName) && r.isPodOfSpecRack(podName) {
+				migrationStatus.PendingPods = append(migrationStatus.PendingPods, podName)
+			}
+		}
+	}
+
+	if currentPodName != "" {
+		migrationStatus.CurrentPod = currentPodName
+	}
+
+	migrationStatus.PendingPods = append(migrationStatus.PendingPods, rackPendingPodNames...)
+	sort.Strings(migrationStatus.PendingPods)
+
+	switch {
+	case len(migrationStatus.PendingPods) == 0:
+		migrationStatus.Phase = asdbv1.StorageClassMigrationCompleted
+	case r.aeroCluster.Spec.StorageClassMigration.Paused:
+		migrationStatus.Phase = asdbv1.StorageClassMigrationPaused
+	default:
+		migrationStatus.Phase = asdbv1.StorageClassMigrationInProgress
+	}
+
+	if reflect.DeepEqual(migrationStatus, r.aeroCluster.Status.StorageClassMigration) {
+		return nil
+	}
+
+	return r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.StorageClassMigration = migrationStatus
+	})
+}
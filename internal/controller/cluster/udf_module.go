@@ -0,0 +1,233 @@
+package cluster
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec // server reports the SHA1 hash of the registered UDF modules
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	as "github.com/aerospike/aerospike-client-go/v7"
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-management-lib/deployment"
+)
+
+const udfModuleExtension = ".lua"
+
+// udfModule is a Lua UDF module read from a ConfigMap.
+type udfModule struct {
+	name          string
+	configMapName string
+	content       []byte
+	err           error
+}
+
+// reconcileUDFModules registers the UDF modules of the referenced ConfigMaps which are missing in the server or
+// have a different content, and removes the modules not referenced anymore. Only the modules tracked in status are
+// removed.
+func (r *SingleClusterReconciler) reconcileUDFModules(allHostConns []*deployment.HostConn) error {
+	if len(r.aeroCluster.Spec.UDFModules) == 0 && len(r.aeroCluster.Status.UDFModules) == 0 {
+		return nil
+	}
+
+	modules, failedConfigMaps := r.getUDFModules()
+
+	aeroClient, err := r.newAerospikeClient(allHostConns)
+	if err != nil {
+		return err
+	}
+
+	defer aeroClient.Close()
+
+	serverUDFs, lErr := aeroClient.ListUDF(nil)
+	if lErr != nil {
+		return fmt.Errorf("failed to list UDF modules: %v", lErr)
+	}
+
+	serverHashes := make(map[string]string, len(serverUDFs))
+	for _, udf := range serverUDFs {
+		serverHashes[udf.Filename] = udf.Hash
+	}
+
+	writePolicy := as.NewWritePolicy(0, 0)
+
+	var (
+		newStatusModules []asdbv1.UDFModuleStatus
+		moduleErrs       []string
+	)
+
+	// Remove the modules not referenced anymore. Modules of the ConfigMaps which could not be read are kept.
+	for idx := range r.aeroCluster.Status.UDFModules {
+		statusModule := &r.aeroCluster.Status.UDFModules[idx]
+		if _, ok := modules[statusModule.Name]; ok {
+			continue
+		}
+
+		if cmErr, ok := failedConfigMaps[statusModule.ConfigMapName]; ok {
+			newStatusModules = append(
+				newStatusModules, asdbv1.UDFModuleStatus{
+					Name:          statusModule.Name,
+					ConfigMapName: statusModule.ConfigMapName,
+					Hash:          statusModule.Hash,
+					Phase:         asdbv1.UDFModuleFailed,
+					Message:       cmErr.Error(),
+				},
+			)
+
+			continue
+		}
+
+		if _, ok := serverHashes[statusModule.Name]; !ok {
+			continue
+		}
+
+		if _, rErr := aeroClient.RemoveUDF(writePolicy, statusModule.Name); rErr != nil {
+			return fmt.Errorf("failed to remove UDF module %s: %v", statusModule.Name, rErr)
+		}
+
+		r.Log.Info("Removed UDF module", "module", statusModule.Name)
+		r.Recorder.Eventf(
+			r.aeroCluster, corev1.EventTypeNormal, "UDFModuleRemoved", "Removed UDF module %s", statusModule.Name,
+		)
+	}
+
+	for cmName, cmErr := range failedConfigMaps {
+		moduleErrs = append(moduleErrs, fmt.Sprintf("ConfigMap %s: %v", cmName, cmErr))
+	}
+
+	for _, module := range modules {
+		moduleStatus := asdbv1.UDFModuleStatus{
+			Name:          module.name,
+			ConfigMapName: module.configMapName,
+			Phase:         asdbv1.UDFModuleRegistered,
+		}
+
+		if module.err == nil {
+			moduleStatus.Hash = getUDFModuleHash(module.content)
+
+			if serverHashes[module.name] != moduleStatus.Hash {
+				if _, rErr := aeroClient.RegisterUDF(writePolicy, module.content, module.name, as.LUA); rErr != nil {
+					module.err = rErr
+				} else {
+					r.Log.Info("Registered UDF module", "module", module.name, "hash", moduleStatus.Hash)
+					r.Recorder.Eventf(
+						r.aeroCluster, corev1.EventTypeNormal, "UDFModuleRegistered",
+						"Registered UDF module %s from ConfigMap %s", module.name, module.configMapName,
+					)
+				}
+			}
+		}
+
+		if module.err != nil {
+			moduleStatus.Phase = asdbv1.UDFModuleFailed
+			moduleStatus.Message = module.err.Error()
+			moduleStatus.Hash = serverHashes[module.name]
+			moduleErrs = append(moduleErrs, fmt.Sprintf("%s: %v", module.name, module.err))
+
+			r.Recorder.Eventf(
+				r.aeroCluster, corev1.EventTypeWarning, "UDFModuleRegisterFailed",
+				"Failed to register UDF module %s from ConfigMap %s: %v", module.name, module.configMapName, module.err,
+			)
+		}
+
+		newStatusModules = append(newStatusModules, moduleStatus)
+	}
+
+	sort.Slice(
+		newStatusModules, func(i, j int) bool {
+			return newStatusModules[i].Name < newStatusModules[j].Name
+		},
+	)
+
+	if uErr := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.UDFModules = newStatusModules
+	}); uErr != nil {
+		return fmt.Errorf("failed to update UDF modules in status: %v", uErr)
+	}
+
+	if len(moduleErrs) != 0 {
+		sort.Strings(moduleErrs)
+
+		return fmt.Errorf("failed to register UDF modules: %s", strings.Join(moduleErrs, ", "))
+	}
+
+	return nil
+}
+
+// getUDFModules reads the UDF modules from the referenced ConfigMaps keyed by module name.
+// Errors reading a ConfigMap are returned keyed by ConfigMap name.
+func (r *SingleClusterReconciler) getUDFModules() (modules map[string]*udfModule, failedConfigMaps map[string]error) {
+	modules = make(map[string]*udfModule)
+	failedConfigMaps = make(map[string]error)
+
+	for idx := range r.aeroCluster.Spec.UDFModules {
+		source := &r.aeroCluster.Spec.UDFModules[idx]
+
+		configMap := &corev1.ConfigMap{}
+		if err := r.Client.Get(
+			context.TODO(), types.NamespacedName{Name: source.ConfigMapName, Namespace: r.aeroCluster.Namespace},
+			configMap,
+		); err != nil {
+			failedConfigMaps[source.ConfigMapName] = err
+			continue
+		}
+
+		for _, name := range getUDFModuleNames(source, configMap) {
+			module := &udfModule{name: name, configMapName: source.ConfigMapName}
+
+			switch {
+			case modules[name] != nil:
+				module.err = fmt.Errorf("module is also present in ConfigMap %s", modules[name].configMapName)
+				modules[name].err = fmt.Errorf("module is also present in ConfigMap %s", source.ConfigMapName)
+
+				continue
+			case configMap.Data[name] != "":
+				module.content = []byte(configMap.Data[name])
+			case len(configMap.BinaryData[name]) != 0:
+				module.content = configMap.BinaryData[name]
+			default:
+				module.err = fmt.Errorf("module is not present in ConfigMap %s", source.ConfigMapName)
+			}
+
+			modules[name] = module
+		}
+	}
+
+	return modules, failedConfigMaps
+}
+
+// getUDFModuleNames returns the modules of the ConfigMap to be registered.
+func getUDFModuleNames(source *asdbv1.UDFModuleSource, configMap *corev1.ConfigMap) []string {
+	if len(source.Modules) != 0 {
+		return source.Modules
+	}
+
+	var names []string
+
+	for key := range configMap.Data {
+		if strings.HasSuffix(key, udfModuleExtension) {
+			names = append(names, key)
+		}
+	}
+
+	for key := range configMap.BinaryData {
+		if strings.HasSuffix(key, udfModuleExtension) {
+			names = append(names, key)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// getUDFModuleHash returns the hash of the module content in the format reported by the server.
+func getUDFModuleHash(content []byte) string {
+	hash := sha1.Sum(content) //nolint:gosec // server reports the SHA1 hash of the registered UDF modules
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,172 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+// reconcileUpgradeRollback rolls back the image upgrade if too many pods have failed on the new image or the
+// upgrade has timed out. The last known-good image is restored in spec.image, the pods are rolled back to it by the
+// reconcile of the restored spec.
+func (r *SingleClusterReconciler) reconcileUpgradeRollback(ignorablePodNames sets.Set[string]) common.ReconcileResult {
+	rollbackSpec := r.getUpgradeRollbackSpec()
+
+	if rollbackSpec == nil || r.IsStatusEmpty() || r.aeroCluster.Status.Image == r.aeroCluster.Spec.Image {
+		return common.ReconcileSuccess()
+	}
+
+	upgradeStatus := r.aeroCluster.Status.Upgrade
+	if upgradeStatus == nil || upgradeStatus.Image != r.aeroCluster.Spec.Image {
+		upgradeStatus = &asdbv1.ImageUpgradeStatus{
+			StartTime:          metav1.Now(),
+			Image:              r.aeroCluster.Spec.Image,
+			LastKnownGoodImage: r.aeroCluster.Status.Image,
+		}
+
+		if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+			status.Upgrade = upgradeStatus
+		}); err != nil {
+			return common.ReconcileError(fmt.Errorf("failed to update image upgrade status: %v", err))
+		}
+	}
+
+	reason, err := r.getUpgradeFailureReason(rollbackSpec, upgradeStatus, ignorablePodNames)
+	if err != nil {
+		return common.ReconcileError(err)
+	}
+
+	if reason == "" {
+		return common.ReconcileSuccess()
+	}
+
+	return r.rollbackUpgrade(upgradeStatus, reason)
+}
+
+// getUpgradeFailureReason returns the reason to roll back the upgrade, or an empty string if the upgrade has not
+// failed.
+func (r *SingleClusterReconciler) getUpgradeFailureReason(
+	rollbackSpec *asdbv1.UpgradeRollbackSpec, upgradeStatus *asdbv1.ImageUpgradeStatus,
+	ignorablePodNames sets.Set[string],
+) (string, error) {
+	podList, err := r.getClusterPodList()
+	if err != nil {
+		return "", err
+	}
+
+	threshold := max(rollbackSpec.FailedPodsThreshold, 1)
+
+	var failedPodErrs []error
+
+	for idx := range podList.Items {
+		pod := &podList.Items[idx]
+		if ignorablePodNames.Has(pod.Name) || !r.isPodOnDesiredImage(pod, false) {
+			continue
+		}
+
+		if pErr := utils.CheckPodFailed(pod); pErr != nil {
+			failedPodErrs = append(failedPodErrs, pErr)
+		}
+	}
+
+	if len(failedPodErrs) >= int(threshold) {
+		return fmt.Sprintf(
+			"%d pods failed on image %s: %v", len(failedPodErrs), upgradeStatus.Image, failedPodErrs[0],
+		), nil
+	}
+
+	if rollbackSpec.Timeout != nil && time.Since(upgradeStatus.StartTime.Time) > rollbackSpec.Timeout.Duration {
+		return fmt.Sprintf(
+			"upgrade to image %s did not complete in %s", upgradeStatus.Image, rollbackSpec.Timeout.Duration,
+		), nil
+	}
+
+	return "", nil
+}
+
+// rollbackUpgrade restores the last known-good image in spec.image and records the rollback in status.
+func (r *SingleClusterReconciler) rollbackUpgrade(
+	upgradeStatus *asdbv1.ImageUpgradeStatus, reason string,
+) common.ReconcileResult {
+	r.Log.Info(
+		"Rolling back image upgrade", "failedImage", upgradeStatus.Image, "image", upgradeStatus.LastKnownGoodImage,
+		"reason", reason,
+	)
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestAeroCluster := &asdbv1.AerospikeCluster{}
+		if err := r.Client.Get(
+			context.TODO(), utils.GetNamespacedName(r.aeroCluster), latestAeroCluster,
+		); err != nil {
+			return err
+		}
+
+		// The image has been changed by the user in the meantime, the new image is reconciled instead.
+		if latestAeroCluster.Spec.Image != upgradeStatus.Image {
+			return nil
+		}
+
+		latestAeroCluster.Spec.Image = upgradeStatus.LastKnownGoodImage
+
+		return r.Client.Update(context.TODO(), latestAeroCluster, common.UpdateOption)
+	}); err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to restore image %s: %v", upgradeStatus.LastKnownGoodImage, err))
+	}
+
+	rollbackStatus := &asdbv1.UpgradeRollbackStatus{
+		Time:        metav1.Now(),
+		FailedImage: upgradeStatus.Image,
+		Image:       upgradeStatus.LastKnownGoodImage,
+		Reason:      reason,
+	}
+
+	if err := r.updateClusterStatus(func(status *asdbv1.AerospikeClusterStatus) {
+		status.Upgrade = nil
+		status.UpgradeRollback = rollbackStatus
+	}); err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to update upgrade rollback status: %v", err))
+	}
+
+	if err := r.setStatusConditions(r.getUpgradeRolledBackCondition(rollbackStatus)); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeWarning, "ImageUpgradeRolledBack",
+		"Rolled back upgrade to image %s, restored image %s: %s", rollbackStatus.FailedImage, rollbackStatus.Image,
+		reason,
+	)
+
+	// The restored spec is reconciled in the next reconcile.
+	return common.ReconcileRequeueAfter(1)
+}
+
+// getUpgradeRolledBackCondition returns the Degraded condition for the rollback of the image upgrade.
+func (r *SingleClusterReconciler) getUpgradeRolledBackCondition(
+	rollbackStatus *asdbv1.UpgradeRollbackStatus,
+) metav1.Condition {
+	return r.newCondition(
+		asdbv1.ConditionTypeDegraded, metav1.ConditionTrue, asdbv1.ConditionReasonUpgradeRolledBack,
+		fmt.Sprintf(
+			"Upgrade to image %s was rolled back to image %s: %s", rollbackStatus.FailedImage, rollbackStatus.Image,
+			rollbackStatus.Reason,
+		),
+	)
+}
+
+func (r *SingleClusterReconciler) getUpgradeRollbackSpec() *asdbv1.UpgradeRollbackSpec {
+	if r.aeroCluster.Spec.UpgradeStrategy == nil {
+		return nil
+	}
+
+	return r.aeroCluster.Spec.UpgradeStrategy.Rollback
+}
@@ -0,0 +1,153 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	asdbv1beta1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1beta1"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+const volumeSnapshotAPIGroup = "snapshot.storage.k8s.io"
+
+// createPVCsFromVolumeSnapshots creates the PVCs of the StatefulSet pods from the VolumeSnapshots of
+// spec.volumeSnapshotSource having the same volume, rack and pod ordinal. The StatefulSet uses the existing PVCs
+// instead of provisioning them from its volumeClaimTemplates. The PVCs are restored only while the cluster is being
+// created.
+func (r *SingleClusterReconciler) createPVCsFromVolumeSnapshots(st *appsv1.StatefulSet, rackState *RackState) error {
+	sourceName := r.aeroCluster.Spec.VolumeSnapshotSource
+	if sourceName == "" || !r.IsStatusEmpty() {
+		return nil
+	}
+
+	source := &asdbv1beta1.AerospikeVolumeSnapshot{}
+	if err := r.Client.Get(
+		context.TODO(), types.NamespacedName{Name: sourceName, Namespace: r.aeroCluster.Namespace}, source,
+	); err != nil {
+		return fmt.Errorf("failed to get volume snapshot source %s: %v", sourceName, err)
+	}
+
+	if source.Status.Phase != asdbv1beta1.AerospikeVolumeSnapshotReady {
+		return fmt.Errorf("volume snapshot source %s is not ready, phase %s", sourceName, source.Status.Phase)
+	}
+
+	snapshotList := &unstructured.UnstructuredList{}
+	snapshotList.SetAPIVersion(volumeSnapshotAPIGroup + "/v1")
+	snapshotList.SetKind("VolumeSnapshotList")
+
+	if err := r.Client.List(
+		context.TODO(), snapshotList, client.InNamespace(r.aeroCluster.Namespace),
+		client.MatchingLabels{
+			asdbv1.AerospikeVolumeSnapshotLabel: sourceName,
+			asdbv1.AerospikeRackIDLabel:         strconv.Itoa(rackState.Rack.ID),
+		},
+	); err != nil {
+		return fmt.Errorf("failed to list VolumeSnapshots of %s: %v", sourceName, err)
+	}
+
+	for idx := range snapshotList.Items {
+		snapshot := &snapshotList.Items[idx]
+		snapshotLabels := snapshot.GetLabels()
+
+		ordinal, err := strconv.Atoi(snapshotLabels[asdbv1.AerospikePodOrdinalLabel])
+		if err != nil || ordinal >= rackState.Size {
+			continue
+		}
+
+		claim := getVolumeClaimTemplate(st, snapshotLabels[asdbv1.AerospikeStorageVolumeLabel])
+		if claim == nil {
+			r.Log.Info(
+				"Skipping VolumeSnapshot of a volume not in the storage", "VolumeSnapshot", snapshot.GetName(),
+			)
+
+			continue
+		}
+
+		if err = r.createPVCFromVolumeSnapshot(st, claim, int32(ordinal), snapshot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createPVCFromVolumeSnapshot creates the PVC of the volume claim template for the pod ordinal, with the VolumeSnapshot
+// as its dataSource.
+func (r *SingleClusterReconciler) createPVCFromVolumeSnapshot(
+	st *appsv1.StatefulSet, claim *corev1.PersistentVolumeClaim, ordinal int32, snapshot *unstructured.Unstructured,
+) error {
+	// The StatefulSet names the PVC of a pod <claim-name>-<pod-name>, and labels it with its selector.
+	pvcLabels := make(map[string]string, len(claim.Labels)+len(st.Spec.Selector.MatchLabels))
+	for k, v := range claim.Labels {
+		pvcLabels[k] = v
+	}
+
+	for k, v := range st.Spec.Selector.MatchLabels {
+		pvcLabels[k] = v
+	}
+
+	apiGroup := volumeSnapshotAPIGroup
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        claim.Name + "-" + getSTSPodName(st.Name, ordinal),
+			Namespace:   st.Namespace,
+			Labels:      pvcLabels,
+			Annotations: claim.Annotations,
+		},
+		Spec: *claim.Spec.DeepCopy(),
+	}
+
+	pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		APIGroup: &apiGroup,
+		Kind:     "VolumeSnapshot",
+		Name:     snapshot.GetName(),
+	}
+
+	// The PVC cannot be smaller than the snapshotted volume.
+	if restoreSize, found, _ := unstructured.NestedString(snapshot.Object, "status", "restoreSize"); found {
+		if size, err := resource.ParseQuantity(restoreSize); err == nil &&
+			size.Cmp(pvc.Spec.Resources.Requests[corev1.ResourceStorage]) > 0 {
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = size
+		}
+	}
+
+	if err := r.Client.Create(context.TODO(), pvc); err != nil {
+		if errors.IsAlreadyExists(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to create PVC %s from VolumeSnapshot %s: %v", pvc.Name, snapshot.GetName(), err)
+	}
+
+	r.Log.Info("Created PVC from VolumeSnapshot", "PVC", pvc.Name, "VolumeSnapshot", snapshot.GetName())
+
+	r.Recorder.Eventf(
+		r.aeroCluster, corev1.EventTypeNormal, "PVCRestored", "Created PVC %s from VolumeSnapshot %s",
+		pvc.Name, snapshot.GetName(),
+	)
+
+	return nil
+}
+
+// getVolumeClaimTemplate returns the volume claim template of the StatefulSet for the storage volume.
+func getVolumeClaimTemplate(st *appsv1.StatefulSet, volName string) *corev1.PersistentVolumeClaim {
+	for idx := range st.Spec.VolumeClaimTemplates {
+		claim := &st.Spec.VolumeClaimTemplates[idx]
+		if claim.Annotations[utils.StorageVolumeAnnotationKey] == volName {
+			return claim
+		}
+	}
+
+	return nil
+}
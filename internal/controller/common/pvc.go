@@ -0,0 +1,30 @@
+package common
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+// GetClusterPVCList returns the PVCs of all the racks of the AerospikeCluster.
+func GetClusterPVCList(
+	k8sClient client.Client, aeroCluster *asdbv1.AerospikeCluster,
+) ([]corev1.PersistentVolumeClaim, error) {
+	// List the pvc for this aeroCluster's statefulset
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	labelSelector := labels.SelectorFromSet(utils.LabelsForAerospikeCluster(aeroCluster.Name))
+	listOps := &client.ListOptions{
+		Namespace: aeroCluster.Namespace, LabelSelector: labelSelector,
+	}
+
+	if err := k8sClient.List(context.TODO(), pvcList, listOps); err != nil {
+		return nil, err
+	}
+
+	return pvcList.Items, nil
+}
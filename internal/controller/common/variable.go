@@ -16,4 +16,7 @@ var (
 	CreateOption = &client.CreateOptions{
 		FieldManager: "aerospike-operator",
 	}
+	PatchOption = &client.PatchOptions{
+		FieldManager: "aerospike-operator",
+	}
 )
@@ -0,0 +1,656 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	asdbv1beta1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1beta1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+const (
+	// pollingPeriod is the period at which the target cluster and the replication lag are checked.
+	pollingPeriod = 10 * time.Second
+
+	defaultMaxLag = 10 * time.Second
+
+	defaultServicePort = 3000
+)
+
+// SingleMigrationReconciler reconciles a single AerospikeClusterMigration
+type SingleMigrationReconciler struct {
+	client.Client
+	Recorder      record.EventRecorder
+	aeroMigration *asdbv1beta1.AerospikeClusterMigration
+	Scheme        *k8sRuntime.Scheme
+	Log           logr.Logger
+}
+
+func (r *SingleMigrationReconciler) Reconcile() (result ctrl.Result, recErr error) {
+	if !r.aeroMigration.ObjectMeta.DeletionTimestamp.IsZero() {
+		r.Log.Info("Deleting AerospikeClusterMigration")
+
+		if err := r.cleanUpAndRemoveFinalizer(finalizerName); err != nil {
+			r.Log.Error(err, "Failed to remove finalizer")
+			return reconcile.Result{}, err
+		}
+
+		r.Recorder.Eventf(
+			r.aeroMigration, corev1.EventTypeNormal, "Deleted",
+			"Deleted AerospikeClusterMigration %s/%s", r.aeroMigration.Namespace,
+			r.aeroMigration.Name,
+		)
+
+		// Stop reconciliation as the migration is being deleted
+		return reconcile.Result{}, nil
+	}
+
+	if r.aeroMigration.Status.Phase == asdbv1beta1.AerospikeClusterMigrationCompleted &&
+		r.aeroMigration.Spec.DecommissionSource {
+		return reconcile.Result{}, r.decommissionSource()
+	}
+
+	if r.aeroMigration.Status.Phase == asdbv1beta1.AerospikeClusterMigrationCompleted ||
+		r.aeroMigration.Status.Phase == asdbv1beta1.AerospikeClusterMigrationFailed {
+		// Stop reconciliation as the migration is already completed or failed
+		r.Log.Info("Migration already finished, skipping reconciliation", "phase", r.aeroMigration.Status.Phase)
+		return reconcile.Result{}, nil
+	}
+
+	// The migration is not being deleted, add finalizer if not added already
+	if err := r.addFinalizer(finalizerName); err != nil {
+		r.Log.Error(err, "Failed to add finalizer")
+		return reconcile.Result{}, err
+	}
+
+	if res := r.reconcileMigration(); !res.IsSuccess {
+		if res.Err != nil {
+			r.Log.Error(res.Err, "Failed to reconcile migration")
+			r.Recorder.Eventf(r.aeroMigration, corev1.EventTypeWarning, "MigrationReconcileFailed",
+				"Failed to reconcile migration %s/%s", r.aeroMigration.Namespace, r.aeroMigration.Name)
+
+			return res.Result, res.Err
+		}
+
+		return res.Result, nil
+	}
+
+	r.Recorder.Eventf(r.aeroMigration, corev1.EventTypeNormal, "MigrationCompleted",
+		"Migrated cluster %s to cluster %s", r.aeroMigration.Spec.SourceCluster, r.aeroMigration.Spec.TargetCluster)
+
+	r.Log.Info("Reconcile completed successfully")
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileMigration moves the migration through its phases. It returns success only once the migration is
+// completed.
+func (r *SingleMigrationReconciler) reconcileMigration() common.ReconcileResult {
+	if r.aeroMigration.Status.Phase == asdbv1beta1.AerospikeClusterMigrationCuttingOver {
+		// The replication lag is not checked again once the cutover has started.
+		return r.cutOver()
+	}
+
+	sourceCluster, err := r.getCluster(r.aeroMigration.Spec.SourceCluster)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.setFailed(fmt.Sprintf("source cluster %s not found", r.aeroMigration.Spec.SourceCluster))
+		}
+
+		return common.ReconcileError(err)
+	}
+
+	targetCluster, res := r.getOrCreateTargetCluster()
+	if !res.IsSuccess {
+		return res
+	}
+
+	if targetCluster.Status.Phase != asdbv1.AerospikeClusterCompleted {
+		return r.setPhaseAndRequeue(
+			asdbv1beta1.AerospikeClusterMigrationCreatingTarget,
+			fmt.Sprintf("waiting for target cluster %s to be ready", targetCluster.Name),
+		)
+	}
+
+	if err = r.configureXDR(sourceCluster, targetCluster); err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to configure XDR in source cluster: %v", err))
+	}
+
+	dcHealth := r.getXDRDCHealth(sourceCluster)
+	if dcHealth == nil {
+		return r.setPhaseAndRequeue(
+			asdbv1beta1.AerospikeClusterMigrationReplicating,
+			fmt.Sprintf("waiting for the replication lag to be reported by source cluster %s", sourceCluster.Name),
+		)
+	}
+
+	lag := time.Duration(dcHealth.Lag) * time.Second
+	maxLag := r.getMaxLag()
+
+	r.aeroMigration.Status.ReplicationLag = &metav1.Duration{Duration: lag}
+	r.aeroMigration.Status.RecordsInQueue = dcHealth.InQueue
+	r.aeroMigration.Status.LastLagUpdateTime = sourceCluster.Status.ServerHealth.LastRefreshTime.DeepCopy()
+
+	if lag > maxLag {
+		return r.setPhaseAndRequeue(
+			asdbv1beta1.AerospikeClusterMigrationReplicating,
+			fmt.Sprintf("replication lag %s is more than maxLag %s", lag, maxLag),
+		)
+	}
+
+	if !r.aeroMigration.Spec.Approved {
+		return r.setPhaseAndRequeue(
+			asdbv1beta1.AerospikeClusterMigrationAwaitingApproval,
+			fmt.Sprintf("replication lag %s is within maxLag %s, waiting for approval", lag, maxLag),
+		)
+	}
+
+	if err = r.setPhase(
+		asdbv1beta1.AerospikeClusterMigrationCuttingOver,
+		fmt.Sprintf("moving services from cluster %s to cluster %s", sourceCluster.Name, targetCluster.Name),
+	); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	r.Recorder.Eventf(r.aeroMigration, corev1.EventTypeNormal, "MigrationCutoverStarted",
+		"Moving services from cluster %s to cluster %s", sourceCluster.Name, targetCluster.Name)
+
+	return r.cutOver()
+}
+
+// getOrCreateTargetCluster creates the target cluster with the targetSpec if it does not exist.
+func (r *SingleMigrationReconciler) getOrCreateTargetCluster() (*asdbv1.AerospikeCluster, common.ReconcileResult) {
+	targetCluster, err := r.getCluster(r.aeroMigration.Spec.TargetCluster)
+	if err == nil {
+		if targetCluster.Labels[asdbv1beta1.AerospikeClusterMigrationLabel] != r.aeroMigration.Name {
+			return nil, r.setFailed(
+				fmt.Sprintf("target cluster %s is not created by this migration", targetCluster.Name),
+			)
+		}
+
+		return targetCluster, common.ReconcileSuccess()
+	}
+
+	if !errors.IsNotFound(err) {
+		return nil, common.ReconcileError(err)
+	}
+
+	targetCluster = &asdbv1.AerospikeCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.aeroMigration.Spec.TargetCluster,
+			Namespace: r.aeroMigration.Namespace,
+			Labels: map[string]string{
+				asdbv1beta1.AerospikeClusterMigrationLabel: r.aeroMigration.Name,
+			},
+		},
+		Spec: *r.aeroMigration.Spec.TargetSpec.DeepCopy(),
+	}
+
+	r.Log.Info("Creating target cluster", "name", targetCluster.Name)
+
+	if err = r.Client.Create(context.TODO(), targetCluster, common.CreateOption); err != nil {
+		// The target spec is rejected by the AerospikeCluster webhook.
+		if errors.IsForbidden(err) || errors.IsInvalid(err) {
+			return nil, r.setFailed(fmt.Sprintf("failed to create target cluster: %v", err))
+		}
+
+		return nil, common.ReconcileError(fmt.Errorf("failed to create target cluster: %v", err))
+	}
+
+	r.Recorder.Eventf(r.aeroMigration, corev1.EventTypeNormal, "TargetClusterCreated",
+		"Created target cluster %s", targetCluster.Name)
+
+	return targetCluster, common.ReconcileSuccess()
+}
+
+// configureXDR adds the XDR DC of the target cluster in the source cluster aerospikeConfig, and enables the server
+// health of the source cluster to report the replication lag.
+func (r *SingleMigrationReconciler) configureXDR(sourceCluster, targetCluster *asdbv1.AerospikeCluster) error {
+	if findXDRDC(sourceCluster.Spec.AerospikeConfig, r.getDCName()) != nil &&
+		sourceCluster.Spec.ServerHealth != nil {
+		return nil
+	}
+
+	port := defaultServicePort
+	if servicePort := asdbv1.GetServicePort(targetCluster.Spec.AerospikeConfig); servicePort != nil {
+		port = *servicePort
+	}
+
+	namespaces := r.aeroMigration.Spec.XDR.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = asdbv1beta1.GetNamespaceNames(sourceCluster.Spec.AerospikeConfig)
+	}
+
+	// Rewind all the records so that the existing records are shipped along with the new ones.
+	nsConfs := make([]interface{}, 0, len(namespaces))
+	for _, ns := range namespaces {
+		nsConfs = append(nsConfs, map[string]interface{}{"name": ns, "rewind": "all"})
+	}
+
+	dcConf := map[string]interface{}{
+		"name": r.getDCName(),
+		// The headless service of the target cluster resolves to all its pods.
+		"node-address-ports": []interface{}{fmt.Sprintf("%s.%s %d", targetCluster.Name, targetCluster.Namespace, port)},
+		"namespaces":         nsConfs,
+	}
+
+	if r.aeroMigration.Spec.XDR.AuthUser != "" {
+		dcConf["auth-user"] = r.aeroMigration.Spec.XDR.AuthUser
+		dcConf["auth-password-file"] = r.aeroMigration.Spec.XDR.AuthPasswordFile
+	}
+
+	// The XDR DCs list is replaced as a whole by the patch, so the patch fails if the source cluster was changed
+	// since it was read.
+	patch := client.MergeFromWithOptions(sourceCluster.DeepCopy(), client.MergeFromWithOptimisticLock{})
+
+	if findXDRDC(sourceCluster.Spec.AerospikeConfig, r.getDCName()) == nil {
+		xdrConf, ok := sourceCluster.Spec.AerospikeConfig.Value["xdr"].(map[string]interface{})
+		if !ok {
+			xdrConf = make(map[string]interface{})
+			sourceCluster.Spec.AerospikeConfig.Value["xdr"] = xdrConf
+		}
+
+		dcs, _ := xdrConf["dcs"].([]interface{})
+		xdrConf["dcs"] = append(dcs, dcConf)
+	}
+
+	if sourceCluster.Spec.ServerHealth == nil {
+		sourceCluster.Spec.ServerHealth = &asdbv1.ServerHealthSpec{}
+	}
+
+	r.Log.Info("Configuring XDR in source cluster", "name", sourceCluster.Name, "dc", r.getDCName())
+
+	if err := r.Client.Patch(context.TODO(), sourceCluster, patch, common.PatchOption); err != nil {
+		return err
+	}
+
+	r.Recorder.Eventf(r.aeroMigration, corev1.EventTypeNormal, "XDRConfigured",
+		"Configured XDR DC %s in cluster %s to replicate to cluster %s", r.getDCName(), sourceCluster.Name,
+		targetCluster.Name)
+
+	return nil
+}
+
+// getXDRDCHealth returns the replication health of the XDR DC of the target cluster, once the XDR config is
+// applied to the source cluster and reported in its server health.
+func (r *SingleMigrationReconciler) getXDRDCHealth(sourceCluster *asdbv1.AerospikeCluster) *asdbv1.XDRDCHealthStatus {
+	if findXDRDC(sourceCluster.Status.AerospikeConfig, r.getDCName()) == nil ||
+		sourceCluster.Status.ServerHealth == nil {
+		return nil
+	}
+
+	for idx := range sourceCluster.Status.ServerHealth.XDRDCs {
+		if sourceCluster.Status.ServerHealth.XDRDCs[idx].Name == r.getDCName() {
+			return &sourceCluster.Status.ServerHealth.XDRDCs[idx]
+		}
+	}
+
+	return nil
+}
+
+// cutOver pauses the source cluster, moves its seed and LoadBalancer services to the target cluster pods, waits
+// for XDR to ship the records still in queue and removes the XDR DC from the source cluster. The source cluster is
+// kept paused until it is decommissioned. Each step is idempotent so that the cutover can be resumed after a failure.
+func (r *SingleMigrationReconciler) cutOver() common.ReconcileResult {
+	sourceName := r.aeroMigration.Spec.SourceCluster
+
+	targetCluster, err := r.getCluster(r.aeroMigration.Spec.TargetCluster)
+	if err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to get target cluster: %v", err))
+	}
+
+	sourceCluster, err := r.getCluster(sourceName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.setFailed(fmt.Sprintf("source cluster %s deleted during the cutover", sourceName))
+		}
+
+		return common.ReconcileError(err)
+	}
+
+	// Pause the source cluster so that its services are not reconciled back.
+	// Its server health is still refreshed to report the XDR queue, until the XDR DC is removed.
+	xdrConfigured := findXDRDC(sourceCluster.Spec.AerospikeConfig, r.getDCName()) != nil
+
+	if !asdbv1.GetBool(sourceCluster.Spec.Paused) ||
+		(xdrConfigured && sourceCluster.Annotations[asdbv1.RefreshServerHealthWhilePausedAnnotation] != "true") {
+		patch := client.MergeFrom(sourceCluster.DeepCopy())
+		paused := true
+		sourceCluster.Spec.Paused = &paused
+
+		if xdrConfigured {
+			if sourceCluster.Annotations == nil {
+				sourceCluster.Annotations = make(map[string]string)
+			}
+
+			sourceCluster.Annotations[asdbv1.RefreshServerHealthWhilePausedAnnotation] = "true"
+		}
+
+		if err = r.Client.Patch(context.TODO(), sourceCluster, patch, common.PatchOption); err != nil {
+			return common.ReconcileError(fmt.Errorf("failed to pause source cluster: %v", err))
+		}
+	}
+
+	if r.aeroMigration.Status.CutoverTime == nil {
+		// Headless service used as seed has the same name as the cluster.
+		for _, serviceName := range []string{sourceName, sourceName + "-lb"} {
+			if err = r.moveService(serviceName, sourceCluster, targetCluster); err != nil {
+				return common.ReconcileError(fmt.Errorf("failed to move service %s: %v", serviceName, err))
+			}
+		}
+
+		now := metav1.Now()
+		r.aeroMigration.Status.CutoverTime = &now
+
+		if err = r.Client.Status().Update(context.TODO(), r.aeroMigration); err != nil {
+			return common.ReconcileError(err)
+		}
+
+		r.Recorder.Eventf(r.aeroMigration, corev1.EventTypeNormal, "ServicesMoved",
+			"Moved services of cluster %s to cluster %s", sourceName, targetCluster.Name)
+	}
+
+	if xdrConfigured {
+		if drained, msg := r.isXDRDrained(sourceCluster); !drained {
+			return r.setPhaseAndRequeue(asdbv1beta1.AerospikeClusterMigrationCuttingOver, msg)
+		}
+
+		if err = r.removeXDR(); err != nil {
+			return common.ReconcileError(fmt.Errorf("failed to remove XDR from source cluster: %v", err))
+		}
+
+		r.Recorder.Eventf(r.aeroMigration, corev1.EventTypeNormal, "XDRRemoved",
+			"Removed XDR DC %s from cluster %s", r.getDCName(), sourceName)
+	}
+
+	if err = r.setPhase(
+		asdbv1beta1.AerospikeClusterMigrationCompleted,
+		fmt.Sprintf(
+			"services of cluster %s are served by cluster %s, cluster %s is paused until decommissioned",
+			sourceName, targetCluster.Name, sourceName,
+		),
+	); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	return common.ReconcileSuccess()
+}
+
+// isXDRDrained returns true once a server health snapshot of the source cluster taken after the cutover reports
+// no records in queue and no pending recoveries for the XDR DC. Otherwise, it returns the reason to wait.
+func (r *SingleMigrationReconciler) isXDRDrained(sourceCluster *asdbv1.AerospikeCluster) (drained bool, msg string) {
+	dcHealth := r.getXDRDCHealth(sourceCluster)
+	if dcHealth == nil || sourceCluster.Status.ServerHealth.Error != "" ||
+		!sourceCluster.Status.ServerHealth.LastRefreshTime.After(r.aeroMigration.Status.CutoverTime.Time) {
+		return false, fmt.Sprintf("waiting for the XDR queue to be reported by source cluster %s", sourceCluster.Name)
+	}
+
+	r.aeroMigration.Status.RecordsInQueue = dcHealth.InQueue
+
+	if dcHealth.InQueue != 0 || dcHealth.RecoveriesPending != 0 {
+		return false, fmt.Sprintf(
+			"waiting for XDR to ship %d records in queue and %d pending recoveries of source cluster %s",
+			dcHealth.InQueue, dcHealth.RecoveriesPending, sourceCluster.Name,
+		)
+	}
+
+	return true, ""
+}
+
+// decommissionSource deletes the source cluster which is kept paused after the cutover.
+func (r *SingleMigrationReconciler) decommissionSource() error {
+	sourceCluster, err := r.getCluster(r.aeroMigration.Spec.SourceCluster)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if !sourceCluster.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	r.Log.Info("Decommissioning source cluster", "name", sourceCluster.Name)
+
+	if err = r.Client.Delete(context.TODO(), sourceCluster); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete source cluster: %v", err)
+	}
+
+	r.Recorder.Eventf(r.aeroMigration, corev1.EventTypeNormal, "SourceClusterDeleted",
+		"Deleted source cluster %s", sourceCluster.Name)
+
+	return nil
+}
+
+// moveService selects the target cluster pods in the service of the source cluster. The service is owned by the
+// target cluster so that it is not garbage collected with the source cluster.
+func (r *SingleMigrationReconciler) moveService(
+	serviceName string, sourceCluster, targetCluster *asdbv1.AerospikeCluster,
+) error {
+	service := &corev1.Service{}
+
+	if err := r.Client.Get(
+		context.TODO(), types.NamespacedName{Name: serviceName, Namespace: r.aeroMigration.Namespace}, service,
+	); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	ownerRefs := make([]metav1.OwnerReference, 0, len(service.OwnerReferences))
+
+	for idx := range service.OwnerReferences {
+		if service.OwnerReferences[idx].UID != sourceCluster.UID {
+			ownerRefs = append(ownerRefs, service.OwnerReferences[idx])
+		}
+	}
+
+	service.OwnerReferences = ownerRefs
+
+	if err := controllerutil.SetOwnerReference(targetCluster, service, r.Scheme); err != nil {
+		return err
+	}
+
+	service.Spec.Selector = utils.LabelsForAerospikeCluster(targetCluster.Name)
+
+	r.Log.Info("Moving service to target cluster", "name", serviceName, "target", targetCluster.Name)
+
+	return r.Client.Update(context.TODO(), service, common.UpdateOption)
+}
+
+// removeXDR removes the XDR DC of the target cluster from the source cluster aerospikeConfig.
+func (r *SingleMigrationReconciler) removeXDR() error {
+	sourceCluster, err := r.getCluster(r.aeroMigration.Spec.SourceCluster)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if findXDRDC(sourceCluster.Spec.AerospikeConfig, r.getDCName()) == nil {
+		return nil
+	}
+
+	patch := client.MergeFromWithOptions(sourceCluster.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	xdrConf := sourceCluster.Spec.AerospikeConfig.Value["xdr"].(map[string]interface{})
+	dcs := xdrConf["dcs"].([]interface{})
+	remainingDCs := make([]interface{}, 0, len(dcs))
+
+	for _, dcConfInterface := range dcs {
+		if dcConf, ok := dcConfInterface.(map[string]interface{}); !ok || dcConf["name"] != r.getDCName() {
+			remainingDCs = append(remainingDCs, dcConfInterface)
+		}
+	}
+
+	if len(remainingDCs) == 0 {
+		delete(sourceCluster.Spec.AerospikeConfig.Value, "xdr")
+	} else {
+		xdrConf["dcs"] = remainingDCs
+	}
+
+	// The XDR queue is not tracked anymore.
+	delete(sourceCluster.Annotations, asdbv1.RefreshServerHealthWhilePausedAnnotation)
+
+	r.Log.Info("Removing XDR DC from source cluster", "name", sourceCluster.Name, "dc", r.getDCName())
+
+	return r.Client.Patch(context.TODO(), sourceCluster, patch, common.PatchOption)
+}
+
+func (r *SingleMigrationReconciler) getCluster(name string) (*asdbv1.AerospikeCluster, error) {
+	aeroCluster := &asdbv1.AerospikeCluster{}
+
+	if err := r.Client.Get(
+		context.TODO(), types.NamespacedName{Name: name, Namespace: r.aeroMigration.Namespace}, aeroCluster,
+	); err != nil {
+		return nil, err
+	}
+
+	return aeroCluster, nil
+}
+
+func (r *SingleMigrationReconciler) getDCName() string {
+	if r.aeroMigration.Spec.XDR.DCName != "" {
+		return r.aeroMigration.Spec.XDR.DCName
+	}
+
+	return r.aeroMigration.Spec.TargetCluster
+}
+
+func (r *SingleMigrationReconciler) getMaxLag() time.Duration {
+	if r.aeroMigration.Spec.MaxLag.Duration == 0 {
+		return defaultMaxLag
+	}
+
+	return r.aeroMigration.Spec.MaxLag.Duration
+}
+
+func (r *SingleMigrationReconciler) setPhase(phase asdbv1beta1.AerospikeClusterMigrationPhase, msg string) error {
+	if r.aeroMigration.Status.Phase != phase {
+		r.Log.Info("Migration phase changed", "phase", phase, "message", msg)
+	}
+
+	r.aeroMigration.Status.Phase = phase
+	r.aeroMigration.Status.Message = msg
+
+	if err := r.Client.Status().Update(context.TODO(), r.aeroMigration); err != nil {
+		r.Log.Error(err, fmt.Sprintf("Failed to set migration status to %s", phase))
+		return err
+	}
+
+	return nil
+}
+
+func (r *SingleMigrationReconciler) setPhaseAndRequeue(
+	phase asdbv1beta1.AerospikeClusterMigrationPhase, msg string,
+) common.ReconcileResult {
+	if err := r.setPhase(phase, msg); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	return common.ReconcileRequeueAfter(int(pollingPeriod.Seconds()))
+}
+
+// setFailed marks the migration as failed. The migration is not reconciled anymore.
+func (r *SingleMigrationReconciler) setFailed(msg string) common.ReconcileResult {
+	r.Recorder.Event(r.aeroMigration, corev1.EventTypeWarning, "MigrationFailed", msg)
+
+	if err := r.setPhase(asdbv1beta1.AerospikeClusterMigrationFailed, msg); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	return common.ReconcileError(reconcile.TerminalError(fmt.Errorf("%s", msg)))
+}
+
+func (r *SingleMigrationReconciler) addFinalizer(finalizerName string) error {
+	// The object is not being deleted, so if it does not have our finalizer,
+	// then lets add the finalizer and update the object.
+	if !utils.ContainsString(
+		r.aeroMigration.ObjectMeta.Finalizers, finalizerName,
+	) {
+		patch := client.MergeFrom(r.aeroMigration.DeepCopy())
+
+		r.aeroMigration.ObjectMeta.Finalizers = append(
+			r.aeroMigration.ObjectMeta.Finalizers, finalizerName,
+		)
+
+		return r.Client.Patch(context.TODO(), r.aeroMigration, patch)
+	}
+
+	return nil
+}
+
+func (r *SingleMigrationReconciler) cleanUpAndRemoveFinalizer(finalizerName string) error {
+	if utils.ContainsString(r.aeroMigration.ObjectMeta.Finalizers, finalizerName) {
+		r.Log.Info("Removing finalizer")
+
+		// Stop the replication if the migration is abandoned before the cutover.
+		// The target cluster is retained.
+		if r.aeroMigration.Status.Phase != asdbv1beta1.AerospikeClusterMigrationCompleted &&
+			r.aeroMigration.Status.Phase != asdbv1beta1.AerospikeClusterMigrationCuttingOver {
+			if err := r.removeXDR(); err != nil {
+				return err
+			}
+		}
+
+		patch := client.MergeFrom(r.aeroMigration.DeepCopy())
+
+		// Remove finalizer from the list
+		r.aeroMigration.ObjectMeta.Finalizers = utils.RemoveString(
+			r.aeroMigration.ObjectMeta.Finalizers, finalizerName,
+		)
+
+		if err := r.Client.Patch(context.TODO(), r.aeroMigration, patch); err != nil {
+			return err
+		}
+
+		r.Log.Info("Removed finalizer")
+	}
+
+	return nil
+}
+
+// findXDRDC returns the config of the XDR DC with the given name in the aerospikeConfig.
+func findXDRDC(aeroConfig *asdbv1.AerospikeConfigSpec, dcName string) map[string]interface{} {
+	if aeroConfig == nil {
+		return nil
+	}
+
+	xdrConf, ok := aeroConfig.Value["xdr"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	dcs, ok := xdrConf["dcs"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, dcConfInterface := range dcs {
+		if dcConf, ok := dcConfInterface.(map[string]interface{}); ok && dcConf["name"] == dcName {
+			return dcConf
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,621 @@
+package volumesnapshot
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	asdbv1beta1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1beta1"
+	"github.com/aerospike/aerospike-kubernetes-operator/internal/controller/common"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
+)
+
+const (
+	// pollingPeriod is the period at which the quiesced pods and the VolumeSnapshots are checked.
+	pollingPeriod = 10 * time.Second
+
+	quiesceOperationIDPrefix = "vs-"
+)
+
+var volumeSnapshotGVK = schema.GroupVersionKind{
+	Group:   "snapshot.storage.k8s.io",
+	Version: "v1",
+	Kind:    "VolumeSnapshot",
+}
+
+// SingleVolumeSnapshotReconciler reconciles a single AerospikeVolumeSnapshot
+type SingleVolumeSnapshotReconciler struct {
+	client.Client
+	Recorder     record.EventRecorder
+	aeroSnapshot *asdbv1beta1.AerospikeVolumeSnapshot
+	Scheme       *k8sRuntime.Scheme
+	Log          logr.Logger
+}
+
+func (r *SingleVolumeSnapshotReconciler) Reconcile() (result ctrl.Result, recErr error) {
+	if !r.aeroSnapshot.ObjectMeta.DeletionTimestamp.IsZero() {
+		r.Log.Info("Deleting AerospikeVolumeSnapshot")
+
+		if err := r.cleanUpAndRemoveFinalizer(finalizerName); err != nil {
+			r.Log.Error(err, "Failed to remove finalizer")
+			return reconcile.Result{}, err
+		}
+
+		r.Recorder.Eventf(
+			r.aeroSnapshot, corev1.EventTypeNormal, "Deleted",
+			"Deleted AerospikeVolumeSnapshot %s/%s", r.aeroSnapshot.Namespace,
+			r.aeroSnapshot.Name,
+		)
+
+		// Stop reconciliation as the snapshot is being deleted
+		return reconcile.Result{}, nil
+	}
+
+	if r.aeroSnapshot.Status.Phase == asdbv1beta1.AerospikeVolumeSnapshotReady ||
+		r.aeroSnapshot.Status.Phase == asdbv1beta1.AerospikeVolumeSnapshotFailed {
+		// Stop reconciliation as the snapshot is already ready or failed
+		r.Log.Info("Snapshot already finished, skipping reconciliation", "phase", r.aeroSnapshot.Status.Phase)
+		return reconcile.Result{}, nil
+	}
+
+	// The snapshot is not being deleted, add finalizer if not added already
+	if err := r.addFinalizer(finalizerName); err != nil {
+		r.Log.Error(err, "Failed to add finalizer")
+		return reconcile.Result{}, err
+	}
+
+	if res := r.reconcileSnapshot(); !res.IsSuccess {
+		if res.Err != nil {
+			r.Log.Error(res.Err, "Failed to reconcile volume snapshot")
+			r.Recorder.Eventf(r.aeroSnapshot, corev1.EventTypeWarning, "VolumeSnapshotReconcileFailed",
+				"Failed to reconcile volume snapshot %s/%s", r.aeroSnapshot.Namespace, r.aeroSnapshot.Name)
+
+			return res.Result, res.Err
+		}
+
+		return res.Result, nil
+	}
+
+	r.Recorder.Eventf(r.aeroSnapshot, corev1.EventTypeNormal, "VolumeSnapshotReady",
+		"Took %d volume snapshots of cluster %s", len(r.aeroSnapshot.Status.Snapshots), r.aeroSnapshot.Spec.Cluster)
+
+	r.Log.Info("Reconcile completed successfully")
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileSnapshot takes the VolumeSnapshots of the cluster PVCs one pod at a time, preparing each pod as per the
+// consistency. It returns success only once all the VolumeSnapshots are ready to use.
+func (r *SingleVolumeSnapshotReconciler) reconcileSnapshot() common.ReconcileResult {
+	aeroCluster, err := r.getCluster()
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.setFailed(fmt.Sprintf("cluster %s not found", r.aeroSnapshot.Spec.Cluster))
+		}
+
+		return common.ReconcileError(err)
+	}
+
+	if err = r.refreshSnapshotStatus(); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	podPVCs, err := r.getPodPVCs(aeroCluster)
+	if err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to list PVCs of cluster %s: %v", aeroCluster.Name, err))
+	}
+
+	if len(podPVCs) == 0 && len(r.aeroSnapshot.Status.Snapshots) == 0 {
+		return r.setFailed(fmt.Sprintf("no PVCs found for the volumes of cluster %s", aeroCluster.Name))
+	}
+
+	podNames := make([]string, 0, len(podPVCs))
+	for podName := range podPVCs {
+		podNames = append(podNames, podName)
+	}
+
+	sort.Strings(podNames)
+
+	for _, podName := range podNames {
+		if pvcs := r.getPVCsWithoutSnapshot(podPVCs[podName]); len(pvcs) != 0 {
+			return r.snapshotPod(aeroCluster, podName, pvcs)
+		}
+
+		if res := r.finishPodSnapshot(aeroCluster, podName); !res.IsSuccess {
+			return res
+		}
+	}
+
+	var notReady []string
+
+	for idx := range r.aeroSnapshot.Status.Snapshots {
+		snapshot := &r.aeroSnapshot.Status.Snapshots[idx]
+		if snapshot.ReadyToUse {
+			continue
+		}
+
+		if snapshot.Error != "" {
+			notReady = append(notReady, fmt.Sprintf("%s (%s)", snapshot.Name, snapshot.Error))
+		} else {
+			notReady = append(notReady, snapshot.Name)
+		}
+	}
+
+	if len(notReady) != 0 {
+		return r.setPhaseAndRequeue(
+			asdbv1beta1.AerospikeVolumeSnapshotInProgress,
+			fmt.Sprintf("waiting for VolumeSnapshots to be ready to use: %s", strings.Join(notReady, ", ")),
+		)
+	}
+
+	now := metav1.Now()
+	r.aeroSnapshot.Status.CompletionTime = &now
+
+	if err = r.setPhase(
+		asdbv1beta1.AerospikeVolumeSnapshotReady,
+		fmt.Sprintf("%d VolumeSnapshots are ready to use", len(r.aeroSnapshot.Status.Snapshots)),
+	); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	return common.ReconcileSuccess()
+}
+
+// getPodPVCs returns the PVCs of the cluster volumes to snapshot, keyed by pod name.
+func (r *SingleVolumeSnapshotReconciler) getPodPVCs(
+	aeroCluster *asdbv1.AerospikeCluster,
+) (map[string][]corev1.PersistentVolumeClaim, error) {
+	volumeNames := sets.New(r.aeroSnapshot.Spec.Volumes...)
+	if volumeNames.Len() == 0 {
+		volumeNames = asdbv1beta1.GetPersistentVolumeNames(aeroCluster)
+	}
+
+	pvcs, err := common.GetClusterPVCList(r.Client, aeroCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	podPVCs := make(map[string][]corev1.PersistentVolumeClaim)
+
+	for idx := range pvcs {
+		pvc := &pvcs[idx]
+		if utils.IsPVCTerminating(pvc) {
+			continue
+		}
+
+		volName, ok := utils.GetPVCStorageVolumeName(pvc)
+		if !ok || !volumeNames.Has(volName) {
+			continue
+		}
+
+		podName := strings.TrimPrefix(pvc.Name, volName+"-")
+		podPVCs[podName] = append(podPVCs[podName], *pvc)
+	}
+
+	return podPVCs, nil
+}
+
+// getPVCsWithoutSnapshot returns the PVCs whose VolumeSnapshot is not created yet.
+func (r *SingleVolumeSnapshotReconciler) getPVCsWithoutSnapshot(
+	pvcs []corev1.PersistentVolumeClaim,
+) []corev1.PersistentVolumeClaim {
+	snapshotPVCNames := sets.New[string]()
+	for idx := range r.aeroSnapshot.Status.Snapshots {
+		snapshotPVCNames.Insert(r.aeroSnapshot.Status.Snapshots[idx].PVCName)
+	}
+
+	var pending []corev1.PersistentVolumeClaim
+
+	for idx := range pvcs {
+		if !snapshotPVCNames.Has(pvcs[idx].Name) {
+			pending = append(pending, pvcs[idx])
+		}
+	}
+
+	return pending
+}
+
+// snapshotPod prepares the pod as per the consistency and creates the VolumeSnapshots of its PVCs.
+func (r *SingleVolumeSnapshotReconciler) snapshotPod(
+	aeroCluster *asdbv1.AerospikeCluster, podName string, pvcs []corev1.PersistentVolumeClaim,
+) common.ReconcileResult {
+	switch r.aeroSnapshot.Spec.Consistency {
+	case asdbv1beta1.VolumeSnapshotConsistencyQuiesce:
+		// The volumes of a pod removed from the cluster are not written to.
+		if _, ok := aeroCluster.Status.Pods[podName]; ok {
+			if res := r.quiescePod(aeroCluster, podName); !res.IsSuccess {
+				return res
+			}
+		}
+
+	case asdbv1beta1.VolumeSnapshotConsistencyNone, "":
+	}
+
+	for idx := range pvcs {
+		snapshotStatus, err := r.createVolumeSnapshot(&pvcs[idx], podName)
+		if err != nil {
+			return common.ReconcileError(
+				fmt.Errorf("failed to create VolumeSnapshot of PVC %s: %v", pvcs[idx].Name, err),
+			)
+		}
+
+		r.aeroSnapshot.Status.Snapshots = append(r.aeroSnapshot.Status.Snapshots, *snapshotStatus)
+	}
+
+	r.Recorder.Eventf(r.aeroSnapshot, corev1.EventTypeNormal, "VolumeSnapshotsCreated",
+		"Created %d VolumeSnapshots of pod %s", len(pvcs), podName)
+
+	return r.setPhaseAndRequeue(
+		asdbv1beta1.AerospikeVolumeSnapshotInProgress, fmt.Sprintf("taking snapshots of pod %s", podName),
+	)
+}
+
+// finishPodSnapshot waits for the snapshots of a quiesced pod to be taken and undoes the quiesce.
+func (r *SingleVolumeSnapshotReconciler) finishPodSnapshot(
+	aeroCluster *asdbv1.AerospikeCluster, podName string,
+) common.ReconcileResult {
+	if r.aeroSnapshot.Spec.Consistency != asdbv1beta1.VolumeSnapshotConsistencyQuiesce {
+		return common.ReconcileSuccess()
+	}
+
+	for idx := range r.aeroSnapshot.Status.Snapshots {
+		snapshot := &r.aeroSnapshot.Status.Snapshots[idx]
+		if snapshot.PodName == podName && snapshot.CreationTime == nil {
+			return r.setPhaseAndRequeue(
+				asdbv1beta1.AerospikeVolumeSnapshotInProgress,
+				fmt.Sprintf("waiting for the snapshots of quiesced pod %s to be taken", podName),
+			)
+		}
+	}
+
+	opID := getQuiesceOperationID(r.aeroSnapshot, podName)
+
+	for idx := range aeroCluster.Spec.Operations {
+		if aeroCluster.Spec.Operations[idx].ID != opID {
+			continue
+		}
+
+		if err := r.removeQuiesceOperations(aeroCluster, sets.New(opID)); err != nil {
+			return common.ReconcileError(fmt.Errorf("failed to undo quiesce of pod %s: %v", podName, err))
+		}
+
+		break
+	}
+
+	return common.ReconcileSuccess()
+}
+
+// quiescePod adds a Quiesce operation of the pod to the cluster, and waits for the operation to succeed.
+func (r *SingleVolumeSnapshotReconciler) quiescePod(
+	aeroCluster *asdbv1.AerospikeCluster, podName string,
+) common.ReconcileResult {
+	opID := getQuiesceOperationID(r.aeroSnapshot, podName)
+
+	for idx := range aeroCluster.Status.OperationHistory {
+		op := &aeroCluster.Status.OperationHistory[idx]
+		if op.ID == opID && op.Phase == asdbv1.OperationSucceeded {
+			return common.ReconcileSuccess()
+		}
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestAeroCluster, err := r.getCluster()
+		if err != nil {
+			return err
+		}
+
+		for idx := range latestAeroCluster.Spec.Operations {
+			if latestAeroCluster.Spec.Operations[idx].ID == opID {
+				return nil
+			}
+		}
+
+		r.Log.Info("Quiescing pod", "podName", podName, "operationID", opID)
+
+		patch := client.MergeFromWithOptions(latestAeroCluster.DeepCopy(), client.MergeFromWithOptimisticLock{})
+
+		latestAeroCluster.Spec.Operations = append(latestAeroCluster.Spec.Operations, asdbv1.OperationSpec{
+			Kind:    asdbv1.OperationQuiesce,
+			ID:      opID,
+			PodList: []string{podName},
+		})
+
+		return r.Client.Patch(context.TODO(), latestAeroCluster, patch, common.PatchOption)
+	}); err != nil {
+		return common.ReconcileError(fmt.Errorf("failed to quiesce pod %s: %v", podName, err))
+	}
+
+	return r.setPhaseAndRequeue(
+		asdbv1beta1.AerospikeVolumeSnapshotInProgress, fmt.Sprintf("waiting for pod %s to be quiesced", podName),
+	)
+}
+
+// removeQuiesceOperations removes the Quiesce operations with the given IDs from the cluster.
+func (r *SingleVolumeSnapshotReconciler) removeQuiesceOperations(
+	aeroCluster *asdbv1.AerospikeCluster, opIDs sets.Set[string],
+) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestAeroCluster := &asdbv1.AerospikeCluster{}
+
+		if err := r.Client.Get(
+			context.TODO(), utils.GetNamespacedName(aeroCluster), latestAeroCluster,
+		); err != nil {
+			return err
+		}
+
+		operations := make([]asdbv1.OperationSpec, 0, len(latestAeroCluster.Spec.Operations))
+
+		for idx := range latestAeroCluster.Spec.Operations {
+			if !opIDs.Has(latestAeroCluster.Spec.Operations[idx].ID) {
+				operations = append(operations, latestAeroCluster.Spec.Operations[idx])
+			}
+		}
+
+		if len(operations) == len(latestAeroCluster.Spec.Operations) {
+			return nil
+		}
+
+		r.Log.Info("Removing quiesce operations", "operationIDs", sets.List(opIDs))
+
+		patch := client.MergeFromWithOptions(latestAeroCluster.DeepCopy(), client.MergeFromWithOptimisticLock{})
+
+		latestAeroCluster.Spec.Operations = operations
+
+		return r.Client.Patch(context.TODO(), latestAeroCluster, patch, common.PatchOption)
+	})
+}
+
+// createVolumeSnapshot creates the VolumeSnapshot of the PVC owned by the AerospikeVolumeSnapshot.
+// It is labeled with the volume, rack and pod ordinal of the PVC to be matched with the PVCs of a restored cluster.
+func (r *SingleVolumeSnapshotReconciler) createVolumeSnapshot(
+	pvc *corev1.PersistentVolumeClaim, podName string,
+) (*asdbv1beta1.VolumeSnapshotStatus, error) {
+	volName, _ := utils.GetPVCStorageVolumeName(pvc)
+
+	rackID, err := strconv.Atoi(pvc.Labels[asdbv1.AerospikeRackIDLabel])
+	if err != nil {
+		return nil, fmt.Errorf("invalid rack ID label: %v", err)
+	}
+
+	// Pod names are <cluster-name>-<rack-id>-<ordinal>.
+	ordinal := podName[strings.LastIndex(podName, "-")+1:]
+
+	snapshot := &unstructured.Unstructured{}
+	snapshot.SetGroupVersionKind(volumeSnapshotGVK)
+	snapshot.SetName(getVolumeSnapshotName(r.aeroSnapshot, pvc))
+	snapshot.SetNamespace(r.aeroSnapshot.Namespace)
+	snapshot.SetLabels(map[string]string{
+		asdbv1.AerospikeVolumeSnapshotLabel: r.aeroSnapshot.Name,
+		asdbv1.AerospikeRackIDLabel:         strconv.Itoa(rackID),
+		asdbv1.AerospikeStorageVolumeLabel:  volName,
+		asdbv1.AerospikePodOrdinalLabel:     ordinal,
+	})
+
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": pvc.Name,
+		},
+	}
+
+	if r.aeroSnapshot.Spec.VolumeSnapshotClassName != "" {
+		spec["volumeSnapshotClassName"] = r.aeroSnapshot.Spec.VolumeSnapshotClassName
+	}
+
+	snapshot.Object["spec"] = spec
+
+	// The VolumeSnapshots are garbage collected with the AerospikeVolumeSnapshot.
+	if err = controllerutil.SetOwnerReference(r.aeroSnapshot, snapshot, r.Scheme); err != nil {
+		return nil, err
+	}
+
+	r.Log.Info("Creating VolumeSnapshot", "name", snapshot.GetName(), "PVC", pvc.Name)
+
+	if err = r.Client.Create(context.TODO(), snapshot, common.CreateOption); err != nil && !errors.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	return &asdbv1beta1.VolumeSnapshotStatus{
+		Name:       snapshot.GetName(),
+		PVCName:    pvc.Name,
+		PodName:    podName,
+		VolumeName: volName,
+		RackID:     rackID,
+	}, nil
+}
+
+// refreshSnapshotStatus updates the status of the created VolumeSnapshots from their status.
+func (r *SingleVolumeSnapshotReconciler) refreshSnapshotStatus() error {
+	for idx := range r.aeroSnapshot.Status.Snapshots {
+		snapshotStatus := &r.aeroSnapshot.Status.Snapshots[idx]
+
+		snapshot := &unstructured.Unstructured{}
+		snapshot.SetGroupVersionKind(volumeSnapshotGVK)
+
+		if err := r.Client.Get(
+			context.TODO(), types.NamespacedName{Name: snapshotStatus.Name, Namespace: r.aeroSnapshot.Namespace},
+			snapshot,
+		); err != nil {
+			if errors.IsNotFound(err) {
+				snapshotStatus.ReadyToUse = false
+				snapshotStatus.Error = "VolumeSnapshot not found"
+
+				continue
+			}
+
+			return fmt.Errorf("failed to get VolumeSnapshot %s: %v", snapshotStatus.Name, err)
+		}
+
+		snapshotStatus.ReadyToUse, _, _ = unstructured.NestedBool(snapshot.Object, "status", "readyToUse")
+		snapshotStatus.Error, _, _ = unstructured.NestedString(snapshot.Object, "status", "error", "message")
+
+		if creationTime, found, _ := unstructured.NestedString(
+			snapshot.Object, "status", "creationTime",
+		); found {
+			if parsedTime, err := time.Parse(time.RFC3339, creationTime); err == nil {
+				snapshotStatus.CreationTime = &metav1.Time{Time: parsedTime}
+			}
+		}
+
+		if restoreSize, found, _ := unstructured.NestedString(
+			snapshot.Object, "status", "restoreSize",
+		); found {
+			if quantity, err := resource.ParseQuantity(restoreSize); err == nil {
+				snapshotStatus.RestoreSize = &quantity
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *SingleVolumeSnapshotReconciler) getCluster() (*asdbv1.AerospikeCluster, error) {
+	aeroCluster := &asdbv1.AerospikeCluster{}
+
+	if err := r.Client.Get(
+		context.TODO(), types.NamespacedName{Name: r.aeroSnapshot.Spec.Cluster, Namespace: r.aeroSnapshot.Namespace},
+		aeroCluster,
+	); err != nil {
+		return nil, err
+	}
+
+	return aeroCluster, nil
+}
+
+func (r *SingleVolumeSnapshotReconciler) setPhase(phase asdbv1beta1.AerospikeVolumeSnapshotPhase, msg string) error {
+	if r.aeroSnapshot.Status.Phase != phase {
+		r.Log.Info("Volume snapshot phase changed", "phase", phase, "message", msg)
+	}
+
+	r.aeroSnapshot.Status.Phase = phase
+	r.aeroSnapshot.Status.Message = msg
+
+	if err := r.Client.Status().Update(context.TODO(), r.aeroSnapshot); err != nil {
+		r.Log.Error(err, fmt.Sprintf("Failed to set volume snapshot status to %s", phase))
+		return err
+	}
+
+	return nil
+}
+
+func (r *SingleVolumeSnapshotReconciler) setPhaseAndRequeue(
+	phase asdbv1beta1.AerospikeVolumeSnapshotPhase, msg string,
+) common.ReconcileResult {
+	if err := r.setPhase(phase, msg); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	return common.ReconcileRequeueAfter(int(pollingPeriod.Seconds()))
+}
+
+// setFailed marks the snapshot as failed. The snapshot is not reconciled anymore.
+func (r *SingleVolumeSnapshotReconciler) setFailed(msg string) common.ReconcileResult {
+	r.Recorder.Event(r.aeroSnapshot, corev1.EventTypeWarning, "VolumeSnapshotFailed", msg)
+
+	if err := r.setPhase(asdbv1beta1.AerospikeVolumeSnapshotFailed, msg); err != nil {
+		return common.ReconcileError(err)
+	}
+
+	return common.ReconcileError(reconcile.TerminalError(fmt.Errorf("%s", msg)))
+}
+
+func (r *SingleVolumeSnapshotReconciler) addFinalizer(finalizerName string) error {
+	// The object is not being deleted, so if it does not have our finalizer,
+	// then lets add the finalizer and update the object.
+	if !utils.ContainsString(
+		r.aeroSnapshot.ObjectMeta.Finalizers, finalizerName,
+	) {
+		patch := client.MergeFrom(r.aeroSnapshot.DeepCopy())
+
+		r.aeroSnapshot.ObjectMeta.Finalizers = append(
+			r.aeroSnapshot.ObjectMeta.Finalizers, finalizerName,
+		)
+
+		return r.Client.Patch(context.TODO(), r.aeroSnapshot, patch)
+	}
+
+	return nil
+}
+
+func (r *SingleVolumeSnapshotReconciler) cleanUpAndRemoveFinalizer(finalizerName string) error {
+	if utils.ContainsString(r.aeroSnapshot.ObjectMeta.Finalizers, finalizerName) {
+		r.Log.Info("Removing finalizer")
+
+		// Undo the quiesce of a pod if the snapshot is deleted while the pod is quiesced.
+		if r.aeroSnapshot.Spec.Consistency == asdbv1beta1.VolumeSnapshotConsistencyQuiesce {
+			if err := r.removeAllQuiesceOperations(); err != nil {
+				return err
+			}
+		}
+
+		patch := client.MergeFrom(r.aeroSnapshot.DeepCopy())
+
+		// Remove finalizer from the list
+		r.aeroSnapshot.ObjectMeta.Finalizers = utils.RemoveString(
+			r.aeroSnapshot.ObjectMeta.Finalizers, finalizerName,
+		)
+
+		if err := r.Client.Patch(context.TODO(), r.aeroSnapshot, patch); err != nil {
+			return err
+		}
+
+		r.Log.Info("Removed finalizer")
+	}
+
+	return nil
+}
+
+// removeAllQuiesceOperations removes the Quiesce operations added for any pod of the cluster.
+func (r *SingleVolumeSnapshotReconciler) removeAllQuiesceOperations() error {
+	aeroCluster, err := r.getCluster()
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	opIDs := sets.New[string]()
+	for podName := range aeroCluster.Status.Pods {
+		opIDs.Insert(getQuiesceOperationID(r.aeroSnapshot, podName))
+	}
+
+	return r.removeQuiesceOperations(aeroCluster, opIDs)
+}
+
+// getQuiesceOperationID returns the ID of the Quiesce operation of the pod, unique per snapshot and pod within the
+// maximum operation ID length.
+func getQuiesceOperationID(aeroSnapshot *asdbv1beta1.AerospikeVolumeSnapshot, podName string) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(aeroSnapshot.Name + "/" + podName))
+
+	return fmt.Sprintf("%s%08x", quiesceOperationIDPrefix, hash.Sum32())
+}
+
+func getVolumeSnapshotName(
+	aeroSnapshot *asdbv1beta1.AerospikeVolumeSnapshot, pvc *corev1.PersistentVolumeClaim,
+) string {
+	return aeroSnapshot.Name + "-" + pvc.Name
+}
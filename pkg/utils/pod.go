@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -212,3 +213,17 @@ func IsPodReasonUnschedulable(pod *corev1.Pod) (isPodUnschedulable bool, reason
 
 	return false, ""
 }
+
+// GetPodUnschedulableSince returns the time since the pod is unschedulable, or the zero time if the pod is not
+// unschedulable.
+func GetPodUnschedulableSince(pod *corev1.Pod) time.Time {
+	for idx := range pod.Status.Conditions {
+		condition := &pod.Status.Conditions[idx]
+		if condition.Type == corev1.PodScheduled && (condition.Reason == corev1.PodReasonUnschedulable ||
+			condition.Reason == corev1.PodReasonSchedulerError) {
+			return condition.LastTransitionTime.Time
+		}
+	}
+
+	return time.Time{}
+}
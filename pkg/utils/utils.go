@@ -27,6 +27,15 @@ const (
 	ReasonErrImagePull = "ErrImagePull"
 	// ReasonRegistryUnavailable is the http error when pulling image from registry.
 	ReasonRegistryUnavailable = "RegistryUnavailable"
+
+	// StorageVolumeAnnotationKey is the annotation of the cluster PVCs having the name of their storage volume. It is
+	// added while creating the statefulset to make reverse association with the storage volume.
+	StorageVolumeAnnotationKey = "storage-volume"
+	// StorageVolumeLegacyAnnotationKey is the legacy name of StorageVolumeAnnotationKey.
+	StorageVolumeLegacyAnnotationKey = "storage-path"
+
+	// VolumeNodeAffinityConflict is the scheduler message for nodes not matching the node affinity of pod volumes.
+	VolumeNodeAffinityConflict = "volume node affinity conflict"
 )
 
 // ClusterNamespacedName return namespaced name
@@ -88,6 +97,17 @@ func IsPVCTerminating(pvc *corev1.PersistentVolumeClaim) bool {
 	return pvc.DeletionTimestamp != nil
 }
 
+// GetPVCStorageVolumeName returns the name of the storage volume of the PVC from its annotations.
+func GetPVCStorageVolumeName(pvc *corev1.PersistentVolumeClaim) (string, bool) {
+	pvcStorageVolName, ok := pvc.Annotations[StorageVolumeAnnotationKey]
+	if !ok {
+		// Try legacy annotation name.
+		pvcStorageVolName, ok = pvc.Annotations[StorageVolumeLegacyAnnotationKey]
+	}
+
+	return pvcStorageVolName, ok
+}
+
 // GetDesiredImage returns the desired image for the input containerName from the aeroCluster spec.
 func GetDesiredImage(
 	aeroCluster *asdbv1.AerospikeCluster, containerName string,
@@ -11,6 +11,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/util/retry"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
 )
 
 var _ = Describe("AutoScaler", func() {
@@ -50,6 +52,87 @@ var _ = Describe("AutoScaler", func() {
 				validateScaleSubresourceOperation(3, 2, clusterNamespacedName)
 			},
 		)
+
+		It(
+			"Should scale down the cluster below the low watermark", func() {
+				aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+				Expect(err).ToNot(HaveOccurred())
+
+				aeroCluster.Spec.Size = 3
+				aeroCluster.Spec.ServerHealth = &asdbv1.ServerHealthSpec{
+					RefreshPeriod: metav1.Duration{Duration: 10 * time.Second},
+				}
+				Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+				aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+				Expect(err).ToNot(HaveOccurred())
+
+				// The empty cluster is below the low watermark.
+				aeroCluster.Spec.Autoscaling = &asdbv1.AutoscalingSpec{
+					MinSize:          2,
+					MaxSize:          3,
+					LowWatermarkPct:  50,
+					HighWatermarkPct: 90,
+					StepSize:         1,
+				}
+				Expect(k8sClient.Update(ctx, aeroCluster)).ToNot(HaveOccurred())
+
+				Eventually(
+					func() int32 {
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						return aeroCluster.Spec.Size
+					}, 5*time.Minute, 10*time.Second,
+				).Should(Equal(int32(2)))
+
+				Expect(aeroCluster.Status.Autoscaling).ToNot(BeNil())
+				Expect(aeroCluster.Status.Autoscaling.LastScaleTime).ToNot(BeNil())
+
+				Expect(waitForAerospikeCluster(
+					k8sClient, ctx, aeroCluster, 2, retryInterval, getTimeout(2),
+					[]asdbv1.AerospikeClusterPhase{asdbv1.AerospikeClusterCompleted},
+				)).ToNot(HaveOccurred())
+			},
+		)
+
+		It(
+			"Should fail for invalid autoscaling", func() {
+				aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+				Expect(err).ToNot(HaveOccurred())
+
+				aeroCluster.Spec.ServerHealth = &asdbv1.ServerHealthSpec{
+					RefreshPeriod: metav1.Duration{Duration: 10 * time.Second},
+				}
+				Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+				aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Setting minSize more than maxSize")
+
+				aeroCluster.Spec.Autoscaling = &asdbv1.AutoscalingSpec{MinSize: 4, MaxSize: 3}
+				Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+
+				By("Setting bounds not including the cluster size")
+
+				aeroCluster.Spec.Autoscaling = &asdbv1.AutoscalingSpec{MinSize: 4, MaxSize: 5}
+				Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+
+				By("Setting lowWatermarkPct more than highWatermarkPct")
+
+				aeroCluster.Spec.Autoscaling = &asdbv1.AutoscalingSpec{
+					MinSize: 2, MaxSize: 4, LowWatermarkPct: 90, HighWatermarkPct: 80,
+				}
+				Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+
+				By("Setting autoscaling without serverHealth")
+
+				aeroCluster.Spec.Autoscaling = &asdbv1.AutoscalingSpec{MinSize: 2, MaxSize: 4}
+				aeroCluster.Spec.ServerHealth = nil
+				Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+			},
+		)
 	})
 })
 
@@ -0,0 +1,146 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+var _ = Describe(
+	"CanaryUpgrade", func() {
+		ctx := context.TODO()
+		clusterNamespacedName := getNamespacedName("canary-cluster", namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				aeroCluster = createDummyAerospikeCluster(clusterNamespacedName, 3)
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When upgrading with canary strategy", func() {
+				It(
+					"Should upgrade the canary pods and wait for approval", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.UpgradeStrategy = &asdbv1.UpgradeStrategySpec{
+							Canary: &asdbv1.CanaryUpgradeSpec{
+								Pods:            ptr.To(intstr.FromInt32(1)),
+								SoakDuration:    metav1.Duration{Duration: 10 * time.Second},
+								RequireApproval: true,
+								InfoChecks: []asdbv1.InfoCommandCheck{
+									{
+										Command: "statistics",
+										Key:     "cluster_size",
+										Value:   "3",
+									},
+								},
+							},
+						}
+
+						Expect(UpdateClusterImage(aeroCluster, nextImage)).ToNot(HaveOccurred())
+						Expect(k8sClient.Update(ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						Eventually(
+							func() asdbv1.CanaryStage {
+								aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+								Expect(err).ToNot(HaveOccurred())
+
+								if aeroCluster.Status.Canary == nil {
+									return ""
+								}
+
+								return aeroCluster.Status.Canary.Stage
+							}, 5*time.Minute, 5*time.Second,
+						).Should(Equal(asdbv1.CanaryStageAwaitingApproval))
+
+						Expect(aeroCluster.Status.Canary.Pods).To(HaveLen(1))
+
+						By("Checking only the canary pod is upgraded")
+
+						pods, err := getClusterPodList(k8sClient, ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						for idx := range pods.Items {
+							pod := &pods.Items[idx]
+							if pod.Name == aeroCluster.Status.Canary.Pods[0] {
+								Expect(pod.Spec.Containers[0].Image).To(Equal(nextImage))
+							} else {
+								Expect(pod.Spec.Containers[0].Image).ToNot(Equal(nextImage))
+							}
+						}
+
+						By("Approving the canary upgrade")
+
+						if aeroCluster.Annotations == nil {
+							aeroCluster.Annotations = map[string]string{}
+						}
+
+						aeroCluster.Annotations[asdbv1.CanaryApprovalAnnotation] = nextImage
+						Expect(k8sClient.Update(ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						Expect(waitForAerospikeCluster(
+							k8sClient, ctx, aeroCluster, int(aeroCluster.Spec.Size), retryInterval,
+							getTimeout(aeroCluster.Spec.Size), []asdbv1.AerospikeClusterPhase{asdbv1.AerospikeClusterCompleted},
+						)).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(aeroCluster.Status.Canary.Stage).To(Equal(asdbv1.CanaryStageCompleted))
+
+						for podName := range aeroCluster.Status.Pods {
+							Expect(aeroCluster.Status.Pods[podName].Image).To(Equal(nextImage))
+						}
+					},
+				)
+
+				It(
+					"Should fail if both pods and rackID are given", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.UpgradeStrategy = &asdbv1.UpgradeStrategySpec{
+							Canary: &asdbv1.CanaryUpgradeSpec{
+								Pods:   ptr.To(intstr.FromInt32(1)),
+								RackID: ptr.To(1),
+							},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if canary pods are all the cluster pods", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.UpgradeStrategy = &asdbv1.UpgradeStrategySpec{
+							Canary: &asdbv1.CanaryUpgradeSpec{
+								Pods: ptr.To(intstr.FromString("100%")),
+							},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
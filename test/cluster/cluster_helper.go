@@ -11,6 +11,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -634,6 +635,34 @@ func validateReadinessProbe(ctx goctx.Context, k8sClient client.Client, aeroClus
 	return nil
 }
 
+// validateStatusConditions validates the status of the given conditions, observed for the current generation.
+func validateStatusConditions(
+	aeroCluster *asdbv1.AerospikeCluster, expected map[string]metav1.ConditionStatus,
+) error {
+	for condType, status := range expected {
+		condition := meta.FindStatusCondition(aeroCluster.Status.Conditions, condType)
+		if condition == nil {
+			return fmt.Errorf("condition %s not found", condType)
+		}
+
+		if condition.Status != status {
+			return fmt.Errorf(
+				"condition %s status mismatch, expected: %s, found: %s, reason: %s", condType, status,
+				condition.Status, condition.Reason,
+			)
+		}
+
+		if condition.ObservedGeneration != aeroCluster.Generation {
+			return fmt.Errorf(
+				"condition %s observedGeneration mismatch, expected: %d, found: %d", condType,
+				aeroCluster.Generation, condition.ObservedGeneration,
+			)
+		}
+	}
+
+	return nil
+}
+
 func validateDirtyVolumes(
 	ctx goctx.Context, k8sClient client.Client,
 	clusterNamespacedName types.NamespacedName, expectedVolumes []string,
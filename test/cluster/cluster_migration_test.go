@@ -0,0 +1,218 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	asdbv1beta1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1beta1"
+)
+
+var _ = Describe(
+	"ClusterMigration", func() {
+		ctx := context.TODO()
+		sourceName := "migration-source"
+		targetName := "migration-target"
+		sourceNamespacedName := getNamespacedName(sourceName, namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				aeroCluster = createDummyAerospikeCluster(sourceNamespacedName, 2)
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When doing valid operations", func() {
+				It(
+					"Should replicate to the target cluster, cut over and decommission the source cluster", func() {
+						migration := newClusterMigration(aeroCluster, targetName)
+						Expect(k8sClient.Create(ctx, migration)).ToNot(HaveOccurred())
+
+						targetNamespacedName := getNamespacedName(targetName, namespace)
+
+						defer func() {
+							Expect(k8sClient.Delete(ctx, migration)).ToNot(HaveOccurred())
+
+							target, err := getCluster(k8sClient, ctx, targetNamespacedName)
+							if err == nil {
+								Expect(deleteCluster(k8sClient, ctx, target)).ToNot(HaveOccurred())
+							}
+						}()
+
+						By("Waiting for the target cluster")
+
+						Eventually(
+							func() asdbv1.AerospikeClusterPhase {
+								target, err := getCluster(k8sClient, ctx, targetNamespacedName)
+								if err != nil {
+									return ""
+								}
+
+								Expect(target.Labels[asdbv1beta1.AerospikeClusterMigrationLabel]).To(
+									Equal(migration.Name),
+								)
+
+								return target.Status.Phase
+							}, 5*time.Minute, 5*time.Second,
+						).Should(Equal(asdbv1.AerospikeClusterCompleted))
+
+						By("Checking XDR is configured in the source cluster")
+
+						Eventually(
+							func() []interface{} {
+								source, err := getCluster(k8sClient, ctx, sourceNamespacedName)
+								Expect(err).ToNot(HaveOccurred())
+
+								xdrConf, ok := source.Spec.AerospikeConfig.Value["xdr"].(map[string]interface{})
+								if !ok {
+									return nil
+								}
+
+								dcs, _ := xdrConf["dcs"].([]interface{})
+
+								return dcs
+							}, 2*time.Minute, 5*time.Second,
+						).Should(HaveLen(1))
+
+						source, err := getCluster(k8sClient, ctx, sourceNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						dcConf := source.Spec.AerospikeConfig.Value["xdr"].(map[string]interface{})["dcs"].([]interface{})[0]
+						for _, nsConf := range dcConf.(map[string]interface{})["namespaces"].([]interface{}) {
+							Expect(nsConf.(map[string]interface{})["rewind"]).To(Equal("all"))
+						}
+
+						By("Checking the migration waits for the approval")
+
+						Eventually(
+							func() asdbv1beta1.AerospikeClusterMigrationPhase {
+								Expect(k8sClient.Get(
+									ctx, types.NamespacedName{Name: migration.Name, Namespace: namespace}, migration,
+								)).ToNot(HaveOccurred())
+
+								return migration.Status.Phase
+							}, 10*time.Minute, 10*time.Second,
+						).Should(Equal(asdbv1beta1.AerospikeClusterMigrationAwaitingApproval))
+
+						Expect(migration.Status.ReplicationLag).ToNot(BeNil())
+
+						By("Approving the cutover")
+
+						migration.Spec.Approved = true
+						Expect(k8sClient.Update(ctx, migration)).ToNot(HaveOccurred())
+
+						Eventually(
+							func() asdbv1beta1.AerospikeClusterMigrationPhase {
+								Expect(k8sClient.Get(
+									ctx, types.NamespacedName{Name: migration.Name, Namespace: namespace}, migration,
+								)).ToNot(HaveOccurred())
+
+								return migration.Status.Phase
+							}, 10*time.Minute, 10*time.Second,
+						).Should(Equal(asdbv1beta1.AerospikeClusterMigrationCompleted))
+
+						Expect(migration.Status.CutoverTime).ToNot(BeNil())
+
+						By("Checking the source cluster is kept paused without XDR")
+
+						source, err = getCluster(k8sClient, ctx, sourceNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(asdbv1.GetBool(source.Spec.Paused)).To(BeTrue())
+						Expect(source.Spec.AerospikeConfig.Value).ToNot(HaveKey("xdr"))
+
+						By("Decommissioning the source cluster")
+
+						migration.Spec.DecommissionSource = true
+						Expect(k8sClient.Update(ctx, migration)).ToNot(HaveOccurred())
+
+						Eventually(
+							func() bool {
+								_, err := getCluster(k8sClient, ctx, sourceNamespacedName)
+								return k8serrors.IsNotFound(err)
+							}, 5*time.Minute, 5*time.Second,
+						).Should(BeTrue())
+					},
+				)
+			},
+		)
+
+		Context(
+			"When doing invalid operations", func() {
+				It(
+					"Should fail if the source cluster does not exist", func() {
+						migration := newClusterMigration(aeroCluster, targetName)
+						migration.Spec.SourceCluster = "migration-missing"
+
+						Expect(k8sClient.Create(ctx, migration)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if the target cluster is the source cluster", func() {
+						migration := newClusterMigration(aeroCluster, sourceName)
+
+						Expect(k8sClient.Create(ctx, migration)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if the XDR namespace is not in the source cluster", func() {
+						migration := newClusterMigration(aeroCluster, targetName)
+						migration.Spec.XDR.Namespaces = []string{"missing"}
+
+						Expect(k8sClient.Create(ctx, migration)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if maxLag is negative", func() {
+						migration := newClusterMigration(aeroCluster, targetName)
+						migration.Spec.MaxLag = metav1.Duration{Duration: -time.Second}
+
+						Expect(k8sClient.Create(ctx, migration)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if decommissionSource is set without approved", func() {
+						migration := newClusterMigration(aeroCluster, targetName)
+						migration.Spec.DecommissionSource = true
+
+						Expect(k8sClient.Create(ctx, migration)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
+
+// newClusterMigration returns a migration of the source cluster to a target cluster with the source cluster spec.
+func newClusterMigration(
+	source *asdbv1.AerospikeCluster, targetName string,
+) *asdbv1beta1.AerospikeClusterMigration {
+	return &asdbv1beta1.AerospikeClusterMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      source.Name + "-migration",
+			Namespace: source.Namespace,
+		},
+		Spec: asdbv1beta1.AerospikeClusterMigrationSpec{
+			SourceCluster: source.Name,
+			TargetCluster: targetName,
+			TargetSpec:    *source.Spec.DeepCopy(),
+		},
+	}
+}
@@ -8,7 +8,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
@@ -163,6 +165,13 @@ func PauseReconcileTest(ctx goctx.Context) {
 			)
 			Expect(err).To(HaveOccurred())
 
+			aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(meta.IsStatusConditionTrue(aeroCluster.Status.Conditions, asdbv1.ConditionTypeProgressing)).To(
+				BeTrue(),
+			)
+
 			// Resume reconcile and Wait for all pods to be upgraded
 			By("3. Resume reconcile and upgrade should succeed")
 
@@ -179,6 +188,18 @@ func PauseReconcileTest(ctx goctx.Context) {
 				getTimeout(2), []asdbv1.AerospikeClusterPhase{asdbv1.AerospikeClusterCompleted},
 			)
 			Expect(err).ToNot(HaveOccurred())
+
+			aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = validateStatusConditions(
+				aeroCluster, map[string]metav1.ConditionStatus{
+					asdbv1.ConditionTypeAvailable:   metav1.ConditionTrue,
+					asdbv1.ConditionTypeProgressing: metav1.ConditionFalse,
+					asdbv1.ConditionTypeDegraded:    metav1.ConditionFalse,
+				},
+			)
+			Expect(err).ToNot(HaveOccurred())
 		},
 	)
 }
@@ -687,6 +708,18 @@ func DeployClusterForAllImagesPost570(ctx goctx.Context) {
 				err = validateReadinessProbe(ctx, k8sClient, aeroCluster, serviceTLSPort)
 				Expect(err).ToNot(HaveOccurred())
 
+				By("Validating status conditions")
+
+				err = validateStatusConditions(
+					aeroCluster, map[string]metav1.ConditionStatus{
+						asdbv1.ConditionTypeAvailable:           metav1.ConditionTrue,
+						asdbv1.ConditionTypeProgressing:         metav1.ConditionFalse,
+						asdbv1.ConditionTypeDegraded:            metav1.ConditionFalse,
+						asdbv1.ConditionTypeConfigDriftDetected: metav1.ConditionFalse,
+					},
+				)
+				Expect(err).ToNot(HaveOccurred())
+
 				_ = deleteCluster(k8sClient, ctx, aeroCluster)
 			},
 		)
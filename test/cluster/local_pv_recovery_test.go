@@ -0,0 +1,82 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+var _ = Describe(
+	"LocalPVRecovery", func() {
+		ctx := context.TODO()
+		clusterName := "local-pv-recovery"
+		clusterNamespacedName := getNamespacedName(clusterName, namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				aeroCluster = createDummyAerospikeCluster(clusterNamespacedName, 2)
+				aeroCluster.Spec.Storage.LocalStorageClasses = []string{storageClass}
+				aeroCluster.Spec.Storage.LocalPVRecovery = &asdbv1.LocalPVRecoverySpec{
+					Enabled: true,
+				}
+			},
+		)
+
+		Context(
+			"When doing valid operations", func() {
+				AfterEach(
+					func() {
+						Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should deploy a cluster with local PV recovery and default the grace period", func() {
+						Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(aeroCluster.Spec.Storage.LocalPVRecovery.GracePeriod).ToNot(BeNil())
+						Expect(aeroCluster.Spec.Storage.LocalPVRecovery.GracePeriod.Duration).To(
+							Equal(asdbv1.DefaultLocalPVRecoveryGracePeriod),
+						)
+
+						By("Updating the grace period")
+
+						aeroCluster.Spec.Storage.LocalPVRecovery.GracePeriod = &metav1.Duration{Duration: time.Minute}
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+					},
+				)
+			},
+		)
+
+		Context(
+			"When doing invalid operations", func() {
+				It(
+					"Should fail if localStorageClasses is not set", func() {
+						aeroCluster.Spec.Storage.LocalStorageClasses = nil
+
+						err := deployCluster(k8sClient, ctx, aeroCluster)
+						Expect(err).Should(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if the grace period is negative", func() {
+						aeroCluster.Spec.Storage.LocalPVRecovery.GracePeriod = &metav1.Duration{Duration: -time.Minute}
+
+						err := deployCluster(k8sClient, ctx, aeroCluster)
+						Expect(err).Should(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
@@ -0,0 +1,144 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+var _ = Describe(
+	"MaintenanceWindow", func() {
+		ctx := context.TODO()
+		clusterName := "maintenance-window"
+		clusterNamespacedName := getNamespacedName(clusterName, namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				aeroCluster = createDummyAerospikeCluster(clusterNamespacedName, 2)
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When doing valid operations", func() {
+				It(
+					"Should defer the rolling restart until the maintenance window", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						// A daily window starting 12 hours from now is not open during the test.
+						aeroCluster.Spec.MaintenanceWindows = []asdbv1.MaintenanceWindow{
+							{
+								Schedule: fmt.Sprintf("0 %d * * *", (time.Now().UTC().Hour()+12)%24),
+								Duration: metav1.Duration{Duration: time.Hour},
+							},
+						}
+						aeroCluster.Spec.PodSpec.AerospikeContainerSpec.Resources = schedulableResource("1Gi")
+
+						Expect(k8sClient.Update(ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						By("Checking the pod restarts are pending")
+
+						Eventually(
+							func() []asdbv1.DisruptiveWork {
+								aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+								Expect(err).ToNot(HaveOccurred())
+
+								if aeroCluster.Status.MaintenanceWindow == nil {
+									return nil
+								}
+
+								return aeroCluster.Status.MaintenanceWindow.PendingWork
+							}, 2*time.Minute, 2*time.Second,
+						).ShouldNot(BeEmpty())
+
+						Expect(aeroCluster.Status.MaintenanceWindow.NextWindowStart).ToNot(BeNil())
+						Expect(aeroCluster.Status.MaintenanceWindow.PendingWork[0].Type).To(
+							Equal(asdbv1.DisruptiveWorkPodRestart),
+						)
+						Expect(aeroCluster.Status.Phase).ToNot(Equal(asdbv1.AerospikeClusterCompleted))
+
+						pods, err := getClusterPodList(k8sClient, ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						memory := resource.MustParse("1Gi")
+
+						for idx := range pods.Items {
+							Expect(pods.Items[idx].Spec.Containers[0].Resources.Requests.Memory().Equal(memory)).To(
+								BeFalse(), "pod %s is restarted outside the maintenance window", pods.Items[idx].Name,
+							)
+						}
+
+						By("Removing the maintenance windows")
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.MaintenanceWindows = nil
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(aeroCluster.Status.MaintenanceWindow).To(BeNil())
+					},
+				)
+			},
+		)
+
+		Context(
+			"When doing invalid operations", func() {
+				It(
+					"Should fail for an invalid schedule", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.MaintenanceWindows = []asdbv1.MaintenanceWindow{
+							{Schedule: "0 25 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail for a zero duration", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.MaintenanceWindows = []asdbv1.MaintenanceWindow{{Schedule: "0 2 * * *"}}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail for an unknown time zone", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.MaintenanceWindows = []asdbv1.MaintenanceWindow{
+							{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus"},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
@@ -260,6 +260,201 @@ var _ = Describe(
 					},
 				)
 
+				It(
+					"Should execute queued operations one after another", func() {
+						aeroCluster, err := getCluster(
+							k8sClient, ctx, clusterNamespacedName,
+						)
+						Expect(err).ToNot(HaveOccurred())
+
+						oldPodIDs, err := getPodIDs(ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						operations := []asdbv1.OperationSpec{
+							{
+								Kind:    asdbv1.OperationWarmRestart,
+								ID:      "1",
+								PodList: []string{"operations-1-0"},
+							},
+							{
+								Kind:    asdbv1.OperationPodRestart,
+								ID:      "2",
+								PodList: []string{"operations-1-1"},
+							},
+						}
+
+						aeroCluster.Spec.Operations = operations
+
+						err = updateCluster(k8sClient, ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(
+							k8sClient, ctx, clusterNamespacedName,
+						)
+						Expect(err).ToNot(HaveOccurred())
+
+						operationTypeMap := map[string]asdbv1.OperationKind{
+							"operations-1-0": asdbv1.OperationWarmRestart,
+							"operations-1-1": asdbv1.OperationPodRestart,
+						}
+
+						err = validateOperationTypes(ctx, aeroCluster, oldPodIDs, operationTypeMap)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(aeroCluster.Status.OperationHistory).To(HaveLen(2))
+
+						for idx := range aeroCluster.Status.OperationHistory {
+							opStatus := &aeroCluster.Status.OperationHistory[idx]
+							Expect(opStatus.ID).To(Equal(operations[idx].ID))
+							Expect(opStatus.Phase).To(Equal(asdbv1.OperationSucceeded))
+							Expect(opStatus.PodsCompleted).To(Equal(operations[idx].PodList))
+						}
+
+						// Operation 2 starts only after operation 1 is completed.
+						Expect(aeroCluster.Status.OperationHistory[1].StartTime.Before(
+							aeroCluster.Status.OperationHistory[0].EndTime)).To(BeFalse())
+					},
+				)
+
+				It(
+					"Should execute Quiesce and Recluster operations without restarting pods", func() {
+						aeroCluster, err := getCluster(
+							k8sClient, ctx, clusterNamespacedName,
+						)
+						Expect(err).ToNot(HaveOccurred())
+
+						oldPodIDs, err := getPodIDs(ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						operations := []asdbv1.OperationSpec{
+							{
+								Kind:    asdbv1.OperationQuiesce,
+								ID:      "1",
+								PodList: []string{"operations-1-0"},
+							},
+							{
+								Kind: asdbv1.OperationRecluster,
+								ID:   "2",
+							},
+						}
+
+						aeroCluster.Spec.Operations = operations
+
+						err = updateCluster(k8sClient, ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(
+							k8sClient, ctx, clusterNamespacedName,
+						)
+						Expect(err).ToNot(HaveOccurred())
+
+						operationTypeMap := map[string]asdbv1.OperationKind{
+							"operations-1-0": "noRestart",
+							"operations-1-1": "noRestart",
+						}
+
+						err = validateOperationTypes(ctx, aeroCluster, oldPodIDs, operationTypeMap)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(aeroCluster.Status.OperationHistory).To(HaveLen(2))
+
+						for idx := range aeroCluster.Status.OperationHistory {
+							Expect(aeroCluster.Status.OperationHistory[idx].Phase).To(Equal(asdbv1.OperationSucceeded))
+						}
+
+						// Removing the Quiesce operation undoes the quiesce.
+						aeroCluster.Spec.Operations = nil
+
+						err = updateCluster(k8sClient, ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						err = validateOperationTypes(ctx, aeroCluster, oldPodIDs, operationTypeMap)
+						Expect(err).ToNot(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should quiesce all the pods if podList is not given", func() {
+						aeroCluster, err := getCluster(
+							k8sClient, ctx, clusterNamespacedName,
+						)
+						Expect(err).ToNot(HaveOccurred())
+
+						oldPodIDs, err := getPodIDs(ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.Operations = []asdbv1.OperationSpec{
+							{
+								Kind: asdbv1.OperationQuiesce,
+								ID:   "1",
+							},
+						}
+
+						err = updateCluster(k8sClient, ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(
+							k8sClient, ctx, clusterNamespacedName,
+						)
+						Expect(err).ToNot(HaveOccurred())
+
+						operationTypeMap := map[string]asdbv1.OperationKind{
+							"operations-1-0": "noRestart",
+							"operations-1-1": "noRestart",
+						}
+
+						err = validateOperationTypes(ctx, aeroCluster, oldPodIDs, operationTypeMap)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(aeroCluster.Status.OperationHistory).To(HaveLen(1))
+						Expect(aeroCluster.Status.OperationHistory[0].Phase).To(Equal(asdbv1.OperationSucceeded))
+
+						aeroCluster.Spec.Operations = nil
+
+						err = updateCluster(k8sClient, ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should truncate a set and record the result", func() {
+						aeroCluster, err := getCluster(
+							k8sClient, ctx, clusterNamespacedName,
+						)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.Operations = []asdbv1.OperationSpec{
+							{
+								Kind: asdbv1.OperationTruncate,
+								ID:   "1",
+								Truncate: &asdbv1.TruncateSpec{
+									Namespace: "test",
+									Set:       "testset",
+								},
+							},
+						}
+
+						err = updateCluster(k8sClient, ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(
+							k8sClient, ctx, clusterNamespacedName,
+						)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(aeroCluster.Status.OperationHistory).To(HaveLen(1))
+
+						opStatus := aeroCluster.Status.OperationHistory[0]
+						Expect(opStatus.Phase).To(Equal(asdbv1.OperationSucceeded))
+						Expect(opStatus.TruncateLastUpdateTime).ToNot(BeNil())
+						Expect(opStatus.Result).To(
+							Equal(fmt.Sprintf(
+								"truncate:namespace=test;set=testset;lut=%d: ok", opStatus.TruncateLastUpdateTime.UnixNano(),
+							)),
+						)
+					},
+				)
+
 				It(
 					"Should execute podRestart if podSpec is changed with on-demand warm restart", func() {
 						aeroCluster, err := getCluster(
@@ -303,7 +498,7 @@ var _ = Describe(
 		Context(
 			"When doing invalid operations", func() {
 				It(
-					"Should fail if there are more than 1 operations", func() {
+					"Should fail if there are duplicate operation IDs", func() {
 						aeroCluster, err := getCluster(
 							k8sClient, ctx, clusterNamespacedName,
 						)
@@ -316,7 +511,7 @@ var _ = Describe(
 							},
 							{
 								Kind: asdbv1.OperationPodRestart,
-								ID:   "2",
+								ID:   "1",
 							},
 						}
 
@@ -327,6 +522,48 @@ var _ = Describe(
 					},
 				)
 
+				It(
+					"Should fail if pod list is given for Recluster operation", func() {
+						aeroCluster, err := getCluster(
+							k8sClient, ctx, clusterNamespacedName,
+						)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.Operations = []asdbv1.OperationSpec{
+							{
+								Kind:    asdbv1.OperationRecluster,
+								ID:      "1",
+								PodList: []string{"operations-1-0"},
+							},
+						}
+
+						err = updateCluster(k8sClient, ctx, aeroCluster)
+						Expect(err).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if Truncate namespace is not present in aerospikeConfig", func() {
+						aeroCluster, err := getCluster(
+							k8sClient, ctx, clusterNamespacedName,
+						)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.Operations = []asdbv1.OperationSpec{
+							{
+								Kind: asdbv1.OperationTruncate,
+								ID:   "1",
+								Truncate: &asdbv1.TruncateSpec{
+									Namespace: "invalid",
+								},
+							},
+						}
+
+						err = updateCluster(k8sClient, ctx, aeroCluster)
+						Expect(err).To(HaveOccurred())
+					},
+				)
+
 				It(
 					"should fail if invalid pod name is mentioned in the pod list", func() {
 						aeroCluster, err := getCluster(
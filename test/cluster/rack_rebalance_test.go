@@ -0,0 +1,87 @@
+package cluster
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+var _ = Describe(
+	"RackRebalancePolicy", func() {
+		ctx := context.TODO()
+		clusterName := "rack-rebalance"
+		clusterNamespacedName := getNamespacedName(clusterName, namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				aeroCluster = createDummyAerospikeCluster(clusterNamespacedName, 4)
+				aeroCluster.Spec.RackConfig.Racks = getDummyRackConf(1, 2)
+				aeroCluster.Spec.RackConfig.RebalancePolicy = &asdbv1.RackRebalancePolicy{MaxPodsPerStep: 1}
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When doing valid operations", func() {
+				It(
+					"Should move pods to the new rack one step at a time", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.RackConfig.Racks = getDummyRackConf(1, 2, 3)
+						Expect(k8sClient.Update(ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						By("Checking the cluster never exceeds its size by more than a step")
+
+						Eventually(
+							func() bool {
+								newCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+								Expect(err).ToNot(HaveOccurred())
+
+								pods, err := getClusterPodList(k8sClient, ctx, newCluster)
+								Expect(err).ToNot(HaveOccurred())
+								Expect(len(pods.Items)).To(BeNumerically("<=", int(newCluster.Spec.Size)+1))
+
+								return isClusterStateValid(
+									aeroCluster, newCluster, int(aeroCluster.Spec.Size),
+									[]asdbv1.AerospikeClusterPhase{asdbv1.AerospikeClusterCompleted},
+								)
+							}, getTimeout(aeroCluster.Spec.Size), retryInterval,
+						).Should(BeTrue())
+
+						By("Checking the racks reached their configured sizes")
+
+						Expect(validateRackEnabledCluster(k8sClient, ctx, clusterNamespacedName)).ToNot(HaveOccurred())
+					},
+				)
+			},
+		)
+
+		Context(
+			"When doing invalid operations", func() {
+				It(
+					"Should fail if maxDataInMotion is not positive", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.RackConfig.RebalancePolicy.MaxDataInMotion = ptr.To(resource.MustParse("0"))
+
+						Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
@@ -0,0 +1,168 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+var _ = Describe(
+	"RackRollingUpdateStrategy", func() {
+		ctx := context.TODO()
+		clusterName := "rack-rolling-update"
+		clusterNamespacedName := getNamespacedName(clusterName, namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				aeroCluster = createDummyAerospikeClusterWithRF(clusterNamespacedName, 3, 3)
+				aeroCluster.Spec.RackConfig.Racks = getDummyRackConf(1, 2, 3)
+				aeroCluster.Spec.RackConfig.Namespaces = []string{"test"}
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When doing valid operations", func() {
+				It(
+					"Should upgrade racks in the given order and pause after each rack", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.RackConfig.RollingUpdateStrategy = &asdbv1.RackRollingUpdateStrategy{
+							RackOrder:      []int{3, 1},
+							PauseAfterRack: &metav1.Duration{Duration: 30 * time.Second},
+						}
+
+						Expect(UpdateClusterImage(aeroCluster, nextImage)).ToNot(HaveOccurred())
+						Expect(k8sClient.Update(ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						By("Checking the first rack in rackOrder is upgraded first")
+
+						Eventually(
+							func() *asdbv1.RollingUpdatePauseStatus {
+								aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+								Expect(err).ToNot(HaveOccurred())
+
+								return aeroCluster.Status.RollingUpdatePause
+							}, 5*time.Minute, 2*time.Second,
+						).ShouldNot(BeNil())
+
+						Expect(aeroCluster.Status.RollingUpdatePause.RackID).To(Equal(3))
+
+						pods, err := getClusterPodList(k8sClient, ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						for idx := range pods.Items {
+							pod := &pods.Items[idx]
+							if strings.HasPrefix(pod.Name, fmt.Sprintf("%s-3-", clusterName)) {
+								Expect(pod.Spec.Containers[0].Image).To(Equal(nextImage))
+							} else {
+								Expect(pod.Spec.Containers[0].Image).ToNot(Equal(nextImage))
+							}
+						}
+
+						Expect(waitForAerospikeCluster(
+							k8sClient, ctx, aeroCluster, int(aeroCluster.Spec.Size), retryInterval,
+							getTimeout(aeroCluster.Spec.Size), []asdbv1.AerospikeClusterPhase{asdbv1.AerospikeClusterCompleted},
+						)).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(aeroCluster.Status.RollingUpdatePause).To(BeNil())
+
+						for podName := range aeroCluster.Status.Pods {
+							Expect(aeroCluster.Status.Pods[podName].Image).To(Equal(nextImage))
+						}
+					},
+				)
+
+				It(
+					"Should wait for the rolling update policy gates between batches", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						minReadySeconds := int32(20)
+
+						aeroCluster.Spec.RackConfig.RollingUpdatePolicy = &asdbv1.RackRollingUpdatePolicy{
+							MinReadySeconds:   minReadySeconds,
+							WaitForMigrations: true,
+							PostBatchChecks:   []asdbv1.InfoCommandCheck{{Command: "status", Value: "ok"}},
+						}
+						aeroCluster.Spec.PodSpec.AerospikeContainerSpec.Resources = schedulableResource("1Gi")
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						By("Checking the batches are restarted at least minReadySeconds apart")
+
+						pods, err := getClusterPodList(k8sClient, ctx, aeroCluster)
+						Expect(err).ToNot(HaveOccurred())
+
+						readyTimes := make([]time.Time, 0, len(pods.Items))
+
+						for idx := range pods.Items {
+							for _, cond := range pods.Items[idx].Status.Conditions {
+								if cond.Type == corev1.PodReady {
+									readyTimes = append(readyTimes, cond.LastTransitionTime.Time)
+								}
+							}
+						}
+
+						sort.Slice(readyTimes, func(i, j int) bool { return readyTimes[i].Before(readyTimes[j]) })
+
+						for idx := 1; idx < len(readyTimes); idx++ {
+							Expect(readyTimes[idx].Sub(readyTimes[idx-1])).To(
+								BeNumerically(">=", time.Duration(minReadySeconds)*time.Second),
+							)
+						}
+					},
+				)
+			},
+		)
+
+		Context(
+			"When doing invalid operations", func() {
+				It(
+					"Should fail if minReadySeconds is negative", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.RackConfig.RollingUpdatePolicy = &asdbv1.RackRollingUpdatePolicy{
+							MinReadySeconds: -1,
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if rackOrder has an unknown rack", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.RackConfig.RollingUpdateStrategy = &asdbv1.RackRollingUpdateStrategy{
+							RackOrder: []int{4},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
@@ -0,0 +1,136 @@
+package cluster
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+var _ = Describe(
+	"ScaleDownSelection", func() {
+		ctx := context.TODO()
+		clusterName := "scale-down-selection"
+		clusterNamespacedName := getNamespacedName(clusterName, namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				aeroCluster = createDummyAerospikeCluster(clusterNamespacedName, 3)
+				aeroCluster.Spec.PodSpec.MultiPodPerHost = ptr.To(false)
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When doing valid operations", func() {
+				It(
+					"Should remove the highest ordinal pod and replace the selected pod with an empty pod on another node", func() {
+						selectedPodName := clusterName + "-0-0"
+						removedPodName := clusterName + "-0-2"
+
+						pod := &corev1.Pod{}
+						Expect(k8sClient.Get(ctx, getNamespacedName(selectedPodName, namespace), pod)).ToNot(
+							HaveOccurred(),
+						)
+
+						oldK8sNode := pod.Spec.NodeName
+						oldPvcInfo, err := extractPodPVC(pod)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.Size = 2
+						aeroCluster.Spec.ScaleDownSelection = &asdbv1.ScaleDownSelection{
+							PodNames: []string{selectedPodName},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						By("Verifying the highest ordinal pod is removed")
+
+						err = k8sClient.Get(ctx, getNamespacedName(removedPodName, namespace), &corev1.Pod{})
+						Expect(errors.IsNotFound(err)).To(BeTrue())
+
+						By("Verifying the selected pod is recreated on another node with new PVCs")
+
+						validatePodAndPVCMigration(ctx, selectedPodName, oldK8sNode, oldPvcInfo, true)
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(aeroCluster.Status.ScaleDownSelection).ToNot(BeNil())
+						Expect(aeroCluster.Status.ScaleDownSelection.PodK8sNodes).To(
+							HaveKeyWithValue(selectedPodName, oldK8sNode),
+						)
+
+						By("Removing the selection")
+
+						aeroCluster.Spec.ScaleDownSelection = nil
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(aeroCluster.Status.ScaleDownSelection).To(BeNil())
+					},
+				)
+			},
+		)
+
+		Context(
+			"When doing invalid operations", func() {
+				It(
+					"Should fail if pods are selected without a scale-down", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.ScaleDownSelection = &asdbv1.ScaleDownSelection{
+							PodNames: []string{clusterName + "-0-0"},
+						}
+
+						Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if more pods are selected than removed", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.Size = 2
+						aeroCluster.Spec.ScaleDownSelection = &asdbv1.ScaleDownSelection{
+							PodNames: []string{clusterName + "-0-0", clusterName + "-0-1"},
+						}
+
+						Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if the selected pod is not a pod of the cluster", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.Size = 2
+						aeroCluster.Spec.ScaleDownSelection = &asdbv1.ScaleDownSelection{
+							PodNames: []string{"other-cluster-0-0"},
+						}
+
+						Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
@@ -0,0 +1,137 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+var _ = Describe(
+	"SecondaryIndex", func() {
+		ctx := context.TODO()
+		clusterNamespacedName := getNamespacedName("sindex-cluster", namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				aeroCluster = createDummyAerospikeCluster(clusterNamespacedName, 2)
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When managing secondary indexes", func() {
+				It(
+					"Should create and drop secondary indexes", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.SecondaryIndexes = []asdbv1.SecondaryIndexSpec{
+							{
+								Name:      "age-idx",
+								Namespace: "test",
+								Set:       "users",
+								Bin:       "age",
+								Type:      asdbv1.SecondaryIndexTypeNumeric,
+							},
+							{
+								Name:           "tags-idx",
+								Namespace:      "test",
+								Bin:            "tags",
+								Type:           asdbv1.SecondaryIndexTypeString,
+								CollectionType: asdbv1.SecondaryIndexCollectionTypeList,
+								Context: []asdbv1.CDTContextSpec{
+									{
+										Type:  asdbv1.CDTContextTypeListIndex,
+										Value: intstr.FromInt32(0),
+									},
+								},
+							},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						Eventually(
+							func() []asdbv1.SecondaryIndexPhase {
+								aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+								Expect(err).ToNot(HaveOccurred())
+
+								phases := make([]asdbv1.SecondaryIndexPhase, 0, len(aeroCluster.Status.SecondaryIndexes))
+								for idx := range aeroCluster.Status.SecondaryIndexes {
+									phases = append(phases, aeroCluster.Status.SecondaryIndexes[idx].Phase)
+								}
+
+								return phases
+							}, 2*time.Minute, 10*time.Second,
+						).Should(Equal([]asdbv1.SecondaryIndexPhase{asdbv1.SecondaryIndexReady, asdbv1.SecondaryIndexReady}))
+
+						By("Removing a secondary index")
+
+						aeroCluster.Spec.SecondaryIndexes = aeroCluster.Spec.SecondaryIndexes[:1]
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(aeroCluster.Status.SecondaryIndexes).To(HaveLen(1))
+						Expect(aeroCluster.Status.SecondaryIndexes[0].Name).To(Equal("age-idx"))
+					},
+				)
+
+				It(
+					"Should fail if the namespace is not present in aerospikeConfig", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.SecondaryIndexes = []asdbv1.SecondaryIndexSpec{
+							{
+								Name:      "age-idx",
+								Namespace: "invalid",
+								Bin:       "age",
+								Type:      asdbv1.SecondaryIndexTypeNumeric,
+							},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if a secondary index is modified", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.SecondaryIndexes = []asdbv1.SecondaryIndexSpec{
+							{
+								Name:      "age-idx",
+								Namespace: "test",
+								Bin:       "age",
+								Type:      asdbv1.SecondaryIndexTypeNumeric,
+							},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.SecondaryIndexes[0].Bin = "years"
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
@@ -0,0 +1,166 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+var _ = Describe(
+	"StorageClassMigration", func() {
+		ctx := context.TODO()
+		clusterName := "storage-class-migration"
+		clusterNamespacedName := getNamespacedName(clusterName, namespace)
+		newStorageClass := storageClass + "-migration"
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				sc := &storagev1.StorageClass{}
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: storageClass}, sc)).ToNot(HaveOccurred())
+
+				newSC := &storagev1.StorageClass{
+					ObjectMeta:           metav1.ObjectMeta{Name: newStorageClass},
+					Provisioner:          sc.Provisioner,
+					Parameters:           sc.Parameters,
+					ReclaimPolicy:        sc.ReclaimPolicy,
+					VolumeBindingMode:    sc.VolumeBindingMode,
+					AllowVolumeExpansion: sc.AllowVolumeExpansion,
+				}
+
+				err := k8sClient.Create(ctx, newSC)
+				Expect(err == nil || errors.IsAlreadyExists(err)).To(BeTrue())
+
+				aeroCluster = createDummyAerospikeCluster(clusterNamespacedName, 2)
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+				Expect(k8sClient.Delete(ctx, &storagev1.StorageClass{
+					ObjectMeta: metav1.ObjectMeta{Name: newStorageClass},
+				})).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When doing valid operations", func() {
+				It(
+					"Should replace the PVCs of the pods with the new storage class", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.StorageClassMigration = &asdbv1.StorageClassMigrationSpec{}
+						aeroCluster.Spec.Storage.Volumes[1].Source.PersistentVolume.StorageClass = newStorageClass
+						Expect(updateClusterWithTO(k8sClient, ctx, aeroCluster, 20*time.Minute)).ToNot(HaveOccurred())
+
+						By("Verifying the PVCs have the new storage class")
+
+						pvcs, err := getAeroClusterPVCList(aeroCluster, k8sClient)
+						Expect(err).ToNot(HaveOccurred())
+
+						for idx := range pvcs {
+							if pvcs[idx].Annotations["storage-volume"] == "workdir" {
+								Expect(*pvcs[idx].Spec.StorageClassName).To(Equal(newStorageClass))
+							}
+						}
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(aeroCluster.Status.StorageClassMigration).ToNot(BeNil())
+						Expect(aeroCluster.Status.StorageClassMigration.Phase).To(
+							Equal(asdbv1.StorageClassMigrationCompleted),
+						)
+						Expect(aeroCluster.Status.StorageClassMigration.PendingPods).To(BeEmpty())
+					},
+				)
+
+				It(
+					"Should not replace the PVCs while the migration is paused", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.StorageClassMigration = &asdbv1.StorageClassMigrationSpec{Paused: true}
+						aeroCluster.Spec.Storage.Volumes[1].Source.PersistentVolume.StorageClass = newStorageClass
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(aeroCluster.Status.StorageClassMigration).ToNot(BeNil())
+						Expect(aeroCluster.Status.StorageClassMigration.Phase).To(
+							Equal(asdbv1.StorageClassMigrationPaused),
+						)
+						Expect(aeroCluster.Status.StorageClassMigration.PendingPods).To(HaveLen(2))
+					},
+				)
+			},
+		)
+
+		Context(
+			"When doing invalid operations", func() {
+				It(
+					"Should fail if the storage class is changed without storageClassMigration", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.Storage.Volumes[1].Source.PersistentVolume.StorageClass = newStorageClass
+
+						Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if storageClassMigration is removed before the migration is completed", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.StorageClassMigration = &asdbv1.StorageClassMigrationSpec{Paused: true}
+						aeroCluster.Spec.Storage.Volumes[1].Source.PersistentVolume.StorageClass = newStorageClass
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.StorageClassMigration = nil
+
+						Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if the storage class is changed with a namespace of replication-factor 1", func() {
+						rf1ClusterNamespacedName := getNamespacedName(clusterName+"-rf1", namespace)
+						rf1Cluster := createNonSCDummyAerospikeCluster(rf1ClusterNamespacedName, 2)
+						rf1Cluster.Spec.AerospikeConfig.Value["namespaces"] = []interface{}{
+							getNonSCNamespaceConfigWithRF("test", "/test/dev/xvdf", 1),
+						}
+
+						Expect(deployCluster(k8sClient, ctx, rf1Cluster)).ToNot(HaveOccurred())
+
+						defer func() {
+							Expect(deleteCluster(k8sClient, ctx, rf1Cluster)).ToNot(HaveOccurred())
+						}()
+
+						rf1Cluster, err := getCluster(k8sClient, ctx, rf1ClusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						rf1Cluster.Spec.StorageClassMigration = &asdbv1.StorageClassMigrationSpec{}
+						rf1Cluster.Spec.Storage.Volumes[1].Source.PersistentVolume.StorageClass = newStorageClass
+
+						Expect(k8sClient.Update(ctx, rf1Cluster)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
@@ -569,6 +569,134 @@ var _ = Describe(
 								Expect(err).ToNot(HaveOccurred())
 							},
 						)
+
+						It(
+							"Should allow projected, ephemeral and hostPath volumes", func() {
+								aeroCluster, err := getCluster(
+									k8sClient, ctx, clusterNamespacedName,
+								)
+								Expect(err).ToNot(HaveOccurred())
+
+								hostPathType := v1.HostPathDirectoryOrCreate
+								storageClassName := storageClass
+								volumes := []asdbv1.VolumeSpec{
+									{
+										Name: "projected",
+										Source: asdbv1.VolumeSource{
+											Projected: &v1.ProjectedVolumeSource{
+												Sources: []v1.VolumeProjection{
+													{
+														Secret: &v1.SecretProjection{
+															LocalObjectReference: v1.LocalObjectReference{
+																Name: test.AuthSecretName,
+															},
+														},
+													},
+													{
+														DownwardAPI: &v1.DownwardAPIProjection{
+															Items: []v1.DownwardAPIVolumeFile{
+																{
+																	Path: "labels",
+																	FieldRef: &v1.ObjectFieldSelector{
+																		FieldPath: "metadata.labels",
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										Aerospike: &asdbv1.AerospikeServerVolumeAttachment{
+											Path: "/projected",
+										},
+									},
+									{
+										Name: "scratch",
+										Source: asdbv1.VolumeSource{
+											Ephemeral: &v1.EphemeralVolumeSource{
+												VolumeClaimTemplate: &v1.PersistentVolumeClaimTemplate{
+													Spec: v1.PersistentVolumeClaimSpec{
+														AccessModes:      []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+														StorageClassName: &storageClassName,
+														Resources: v1.VolumeResourceRequirements{
+															Requests: v1.ResourceList{
+																v1.ResourceStorage: resource.MustParse("1Gi"),
+															},
+														},
+													},
+												},
+											},
+										},
+										Aerospike: &asdbv1.AerospikeServerVolumeAttachment{
+											Path: "/scratch",
+										},
+									},
+									{
+										Name: "hostpath",
+										Source: asdbv1.VolumeSource{
+											HostPath: &v1.HostPathVolumeSource{
+												Path: "/tmp/aerospike-hostpath",
+												Type: &hostPathType,
+											},
+										},
+										Aerospike: &asdbv1.AerospikeServerVolumeAttachment{
+											Path: "/hostpath",
+										},
+									},
+								}
+
+								aeroCluster.Spec.Storage.Volumes = append(aeroCluster.Spec.Storage.Volumes, volumes...)
+								err = updateCluster(k8sClient, ctx, aeroCluster)
+								Expect(err).ToNot(HaveOccurred())
+
+								// Update
+								aeroCluster, err = getCluster(
+									k8sClient, ctx, clusterNamespacedName,
+								)
+								Expect(err).ToNot(HaveOccurred())
+
+								aeroCluster.Spec.Storage.Volumes[len(aeroCluster.Spec.Storage.Volumes)-1].Source.
+									HostPath.Path = "/tmp/aerospike-hostpath-new"
+								err = updateCluster(k8sClient, ctx, aeroCluster)
+								Expect(err).ToNot(HaveOccurred())
+							},
+						)
+
+						It(
+							"Should fail for invalid projected, csi, ephemeral and hostPath volumes", func() {
+								sources := []asdbv1.VolumeSource{
+									{Projected: &v1.ProjectedVolumeSource{}},
+									{CSI: &v1.CSIVolumeSource{}},
+									{Ephemeral: &v1.EphemeralVolumeSource{}},
+									{HostPath: &v1.HostPathVolumeSource{Path: "relative/path"}},
+									{
+										HostPath: &v1.HostPathVolumeSource{Path: "/tmp"},
+										EmptyDir: &v1.EmptyDirVolumeSource{},
+									},
+								}
+
+								for idx := range sources {
+									aeroCluster, err := getCluster(
+										k8sClient, ctx, clusterNamespacedName,
+									)
+									Expect(err).ToNot(HaveOccurred())
+
+									aeroCluster.Spec.Storage.Volumes = append(
+										aeroCluster.Spec.Storage.Volumes, asdbv1.VolumeSpec{
+											Name:   "invalid",
+											Source: sources[idx],
+											Aerospike: &asdbv1.AerospikeServerVolumeAttachment{
+												Path: "/invalid",
+											},
+										},
+									)
+
+									err = k8sClient.Update(ctx, aeroCluster)
+									Expect(err).Should(HaveOccurred())
+								}
+							},
+						)
 					},
 				)
 				Context(
@@ -0,0 +1,127 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+const testUDFModule = `
+function hello(rec)
+  return "hello"
+end
+`
+
+var _ = Describe(
+	"UDFModule", func() {
+		ctx := context.TODO()
+		clusterNamespacedName := getNamespacedName("udf-cluster", namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+		udfConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "udf-modules",
+				Namespace: namespace,
+			},
+			Data: map[string]string{
+				"hello.lua": testUDFModule,
+			},
+		}
+
+		BeforeEach(
+			func() {
+				Expect(k8sClient.Create(ctx, udfConfigMap.DeepCopy())).ToNot(HaveOccurred())
+
+				aeroCluster = createDummyAerospikeCluster(clusterNamespacedName, 2)
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+				Expect(k8sClient.Delete(ctx, udfConfigMap.DeepCopy())).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When managing UDF modules", func() {
+				It(
+					"Should register and remove UDF modules", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.UDFModules = []asdbv1.UDFModuleSource{
+							{
+								ConfigMapName: udfConfigMap.Name,
+							},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(aeroCluster.Status.UDFModules).To(HaveLen(1))
+						Expect(aeroCluster.Status.UDFModules[0].Name).To(Equal("hello.lua"))
+						Expect(aeroCluster.Status.UDFModules[0].Phase).To(Equal(asdbv1.UDFModuleRegistered))
+						Expect(aeroCluster.Status.UDFModules[0].Hash).ToNot(BeEmpty())
+
+						By("Updating the UDF module in the ConfigMap")
+
+						oldHash := aeroCluster.Status.UDFModules[0].Hash
+
+						configMap := &corev1.ConfigMap{}
+						Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(udfConfigMap), configMap)).ToNot(
+							HaveOccurred(),
+						)
+
+						configMap.Data["hello.lua"] = testUDFModule + "\n-- updated\n"
+						Expect(k8sClient.Update(ctx, configMap)).ToNot(HaveOccurred())
+
+						Eventually(
+							func() string {
+								aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+								Expect(err).ToNot(HaveOccurred())
+								Expect(aeroCluster.Status.UDFModules).To(HaveLen(1))
+
+								return aeroCluster.Status.UDFModules[0].Hash
+							}, 2*time.Minute, 10*time.Second,
+						).ShouldNot(Equal(oldHash))
+
+						By("Removing the UDF modules")
+
+						aeroCluster.Spec.UDFModules = nil
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(aeroCluster.Status.UDFModules).To(BeEmpty())
+					},
+				)
+
+				It(
+					"Should fail if a module does not have .lua extension", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.UDFModules = []asdbv1.UDFModuleSource{
+							{
+								ConfigMapName: udfConfigMap.Name,
+								Modules:       []string{"hello"},
+							},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
@@ -0,0 +1,51 @@
+package cluster
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+var _ = Describe(
+	"UpgradePath", func() {
+		ctx := context.TODO()
+		clusterNamespacedName := getNamespacedName("upgrade-path-cluster", namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				var err error
+
+				aeroCluster, err = getAeroClusterConfig(clusterNamespacedName, version6Image)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When upgrading the server version", func() {
+				It(
+					"Should fail if the upgrade skips a major version", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(UpdateClusterImage(aeroCluster, latestImage)).ToNot(HaveOccurred())
+
+						err = k8sClient.Update(ctx, aeroCluster)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("major versions cannot be skipped"))
+					},
+				)
+			},
+		)
+	},
+)
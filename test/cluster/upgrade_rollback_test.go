@@ -0,0 +1,101 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+var _ = Describe(
+	"UpgradeRollback", func() {
+		ctx := context.TODO()
+		clusterNamespacedName := getNamespacedName("rollback-cluster", namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				aeroCluster = createDummyAerospikeCluster(clusterNamespacedName, 2)
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When the upgrade to a new image fails", func() {
+				It(
+					"Should restore the last known-good image", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						goodImage := aeroCluster.Spec.Image
+
+						aeroCluster.Spec.UpgradeStrategy = &asdbv1.UpgradeStrategySpec{
+							Rollback: &asdbv1.UpgradeRollbackSpec{
+								FailedPodsThreshold: 1,
+							},
+						}
+						aeroCluster.Spec.Image = unavailableImage
+
+						Expect(k8sClient.Update(ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						Eventually(
+							func() string {
+								aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+								Expect(err).ToNot(HaveOccurred())
+
+								return aeroCluster.Spec.Image
+							}, 5*time.Minute, 5*time.Second,
+						).Should(Equal(goodImage))
+
+						Expect(waitForAerospikeCluster(
+							k8sClient, ctx, aeroCluster, int(aeroCluster.Spec.Size), retryInterval,
+							getTimeout(aeroCluster.Spec.Size), []asdbv1.AerospikeClusterPhase{asdbv1.AerospikeClusterCompleted},
+						)).ToNot(HaveOccurred())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(aeroCluster.Status.UpgradeRollback).ToNot(BeNil())
+						Expect(aeroCluster.Status.UpgradeRollback.FailedImage).To(Equal(unavailableImage))
+						Expect(aeroCluster.Status.UpgradeRollback.Image).To(Equal(goodImage))
+
+						degraded := meta.FindStatusCondition(aeroCluster.Status.Conditions, asdbv1.ConditionTypeDegraded)
+						Expect(degraded).ToNot(BeNil())
+						Expect(degraded.Status).To(Equal(metav1.ConditionTrue))
+						Expect(degraded.Reason).To(Equal(asdbv1.ConditionReasonUpgradeRolledBack))
+
+						for podName := range aeroCluster.Status.Pods {
+							Expect(aeroCluster.Status.Pods[podName].Image).To(Equal(goodImage))
+						}
+					},
+				)
+
+				It(
+					"Should fail if rollback timeout is not positive", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.UpgradeStrategy = &asdbv1.UpgradeStrategySpec{
+							Rollback: &asdbv1.UpgradeRollbackSpec{
+								Timeout: &metav1.Duration{},
+							},
+						}
+
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
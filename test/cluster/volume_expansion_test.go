@@ -0,0 +1,112 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+)
+
+var _ = Describe(
+	"VolumeExpansion", func() {
+		ctx := context.TODO()
+		clusterName := "volume-expansion"
+		clusterNamespacedName := getNamespacedName(clusterName, namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				aeroCluster = createDummyAerospikeCluster(clusterNamespacedName, 2)
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When doing valid operations", func() {
+				It(
+					"Should resize the PVCs when the volume size is increased", func() {
+						sc := &storagev1.StorageClass{}
+						Expect(k8sClient.Get(ctx, types.NamespacedName{Name: storageClass}, sc)).ToNot(HaveOccurred())
+
+						if !asdbv1.GetBool(sc.AllowVolumeExpansion) {
+							Skip("Storage class " + storageClass + " does not allow volume expansion")
+						}
+
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						newSize := resource.MustParse("2Gi")
+						aeroCluster.Spec.Storage.Volumes[1].Source.PersistentVolume.Size = newSize
+						Expect(updateCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+
+						By("Verifying the PVCs are resized")
+
+						Eventually(
+							func() bool {
+								pvcs, err := getAeroClusterPVCList(aeroCluster, k8sClient)
+								Expect(err).ToNot(HaveOccurred())
+
+								for idx := range pvcs {
+									if pvcs[idx].Annotations["storage-volume"] != "workdir" {
+										continue
+									}
+
+									capacity := pvcs[idx].Status.Capacity[corev1.ResourceStorage]
+									if capacity.Cmp(newSize) < 0 {
+										return false
+									}
+								}
+
+								return true
+							}, 10*time.Minute, 5*time.Second,
+						).Should(BeTrue())
+
+						aeroCluster, err = getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(aeroCluster.Status.VolumeExpansions).To(BeEmpty())
+
+						By("Verifying the StatefulSet volumeClaimTemplate is updated")
+
+						sts, err := getSTSFromRackID(aeroCluster, asdbv1.DefaultRackID)
+						Expect(err).ToNot(HaveOccurred())
+
+						for idx := range sts.Spec.VolumeClaimTemplates {
+							if sts.Spec.VolumeClaimTemplates[idx].Name == "workdir" {
+								size := sts.Spec.VolumeClaimTemplates[idx].Spec.Resources.Requests[corev1.ResourceStorage]
+								Expect(size.Cmp(newSize)).To(BeZero())
+							}
+						}
+					},
+				)
+			},
+		)
+
+		Context(
+			"When doing invalid operations", func() {
+				It(
+					"Should fail if the volume size is decreased", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.Storage.Volumes[1].Source.PersistentVolume.Size = resource.MustParse("512Mi")
+
+						Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
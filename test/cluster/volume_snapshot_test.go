@@ -0,0 +1,164 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	asdbv1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1"
+	asdbv1beta1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1beta1"
+)
+
+var _ = Describe(
+	"VolumeSnapshot", func() {
+		ctx := context.TODO()
+		clusterName := "volume-snapshot"
+		restoredName := "volume-snapshot-restored"
+		clusterNamespacedName := getNamespacedName(clusterName, namespace)
+		aeroCluster := &asdbv1.AerospikeCluster{}
+
+		BeforeEach(
+			func() {
+				snapshotList := &unstructured.UnstructuredList{}
+				snapshotList.SetAPIVersion("snapshot.storage.k8s.io/v1")
+				snapshotList.SetKind("VolumeSnapshotList")
+
+				if err := k8sClient.List(ctx, snapshotList, client.InNamespace(namespace)); meta.IsNoMatchError(err) {
+					Skip("VolumeSnapshot API is not available in the cluster")
+				}
+
+				aeroCluster = createDummyAerospikeCluster(clusterNamespacedName, 2)
+				Expect(deployCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		AfterEach(
+			func() {
+				Expect(deleteCluster(k8sClient, ctx, aeroCluster)).ToNot(HaveOccurred())
+			},
+		)
+
+		Context(
+			"When doing valid operations", func() {
+				It(
+					"Should snapshot the volumes and restore a new cluster from them", func() {
+						snapshot := newVolumeSnapshot(aeroCluster, []string{"workdir"})
+						snapshot.Spec.Consistency = asdbv1beta1.VolumeSnapshotConsistencyQuiesce
+						Expect(k8sClient.Create(ctx, snapshot)).ToNot(HaveOccurred())
+
+						defer func() {
+							Expect(k8sClient.Delete(ctx, snapshot)).ToNot(HaveOccurred())
+						}()
+
+						By("Waiting for the snapshots to be ready")
+
+						Eventually(
+							func() asdbv1beta1.AerospikeVolumeSnapshotPhase {
+								Expect(k8sClient.Get(
+									ctx, types.NamespacedName{Name: snapshot.Name, Namespace: namespace}, snapshot,
+								)).ToNot(HaveOccurred())
+
+								return snapshot.Status.Phase
+							}, 10*time.Minute, 10*time.Second,
+						).Should(Equal(asdbv1beta1.AerospikeVolumeSnapshotReady))
+
+						Expect(snapshot.Status.Snapshots).To(HaveLen(2))
+
+						for idx := range snapshot.Status.Snapshots {
+							Expect(snapshot.Status.Snapshots[idx].VolumeName).To(Equal("workdir"))
+							Expect(snapshot.Status.Snapshots[idx].ReadyToUse).To(BeTrue())
+						}
+
+						By("Restoring a new cluster from the snapshots")
+
+						restoredCluster := createDummyAerospikeCluster(getNamespacedName(restoredName, namespace), 2)
+						restoredCluster.Spec.Storage.FileSystemVolumePolicy.InputInitMethod = nil
+						restoredCluster.Spec.VolumeSnapshotSource = snapshot.Name
+						Expect(deployCluster(k8sClient, ctx, restoredCluster)).ToNot(HaveOccurred())
+
+						defer func() {
+							Expect(deleteCluster(k8sClient, ctx, restoredCluster)).ToNot(HaveOccurred())
+						}()
+
+						pvcs, err := getAeroClusterPVCList(restoredCluster, k8sClient)
+						Expect(err).ToNot(HaveOccurred())
+
+						for idx := range pvcs {
+							if pvcs[idx].Annotations["storage-volume"] != "workdir" {
+								Expect(pvcs[idx].Spec.DataSource).To(BeNil())
+								continue
+							}
+
+							Expect(pvcs[idx].Spec.DataSource).ToNot(BeNil())
+							Expect(pvcs[idx].Spec.DataSource.Kind).To(Equal("VolumeSnapshot"))
+						}
+					},
+				)
+			},
+		)
+
+		Context(
+			"When doing invalid operations", func() {
+				It(
+					"Should fail if the cluster does not exist", func() {
+						snapshot := newVolumeSnapshot(aeroCluster, nil)
+						snapshot.Spec.Cluster = "volume-snapshot-missing"
+
+						Expect(k8sClient.Create(ctx, snapshot)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if the volume is not a persistent volume of the cluster", func() {
+						snapshot := newVolumeSnapshot(aeroCluster, []string{"missing"})
+
+						Expect(k8sClient.Create(ctx, snapshot)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if the restored volumes are initialized", func() {
+						restoredCluster := createDummyAerospikeCluster(getNamespacedName(restoredName, namespace), 2)
+						restoredCluster.Spec.VolumeSnapshotSource = clusterName + "-snapshot"
+
+						Expect(k8sClient.Create(ctx, restoredCluster)).To(HaveOccurred())
+					},
+				)
+
+				It(
+					"Should fail if volumeSnapshotSource is set after the cluster is created", func() {
+						aeroCluster, err := getCluster(k8sClient, ctx, clusterNamespacedName)
+						Expect(err).ToNot(HaveOccurred())
+
+						aeroCluster.Spec.VolumeSnapshotSource = clusterName + "-snapshot"
+
+						Expect(k8sClient.Update(ctx, aeroCluster)).To(HaveOccurred())
+					},
+				)
+			},
+		)
+	},
+)
+
+// newVolumeSnapshot returns a snapshot of the given volumes of the cluster.
+func newVolumeSnapshot(
+	aeroCluster *asdbv1.AerospikeCluster, volumes []string,
+) *asdbv1beta1.AerospikeVolumeSnapshot {
+	return &asdbv1beta1.AerospikeVolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      aeroCluster.Name + "-snapshot",
+			Namespace: aeroCluster.Namespace,
+		},
+		Spec: asdbv1beta1.AerospikeVolumeSnapshotSpec{
+			Cluster: aeroCluster.Name,
+			Volumes: volumes,
+		},
+	}
+}